@@ -7,9 +7,12 @@ package coin
 import (
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"log"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	cmap "github.com/orcaman/concurrent-map"
 )
@@ -25,21 +28,40 @@ type Coin struct { // might also understand as public chain or token
 	Explorer   string `json:"explorer"`
 	Health     string // the health of the chain
 	Blockheigh int
-	Blocktime  int // in seconds
-	// Chain      ChainType
+	Blocktime  int    // in seconds
+	Chain      string `json:"chain"`    // the chain a token is issued on, eg: ETH, empty for a coin on its own chain
+	Contract   string `json:"contract"` // the token contract on the chain, tells apart tokens sharing a code
 }
 
-var LastID int
-var coinMap cmap.ConcurrentMap
+var coinMap cmap.ConcurrentMap // ID -> coin
+var codeMap cmap.ConcurrentMap // code -> coin, the index of GetCoin
+var lock sync.Mutex            // keeps coinMap and codeMap in step on add and delete
 
 func Init() {
 	if coinMap == nil {
 		coinMap = cmap.New()
 	}
+	if codeMap == nil {
+		codeMap = cmap.New()
+	}
 }
 
-func GenerateCoinID() int {
-	return LastID + 1
+// GenerateStableID - the same key gets the same ID in every run. An ID taken by another key is hashed again
+// with a salt, key#1, key#2 ..., so the fallback is the same in every run with the same IDs taken,
+// an error only if all GENERATED_ID_SALTS IDs are taken
+func GenerateStableID(key string, taken func(id int) bool) (int, error) {
+	for salt := 0; salt <= GENERATED_ID_SALTS; salt++ {
+		salted := key
+		if salt > 0 {
+			salted = fmt.Sprintf("%s#%d", key, salt)
+		}
+		h := fnv.New64a()
+		h.Write([]byte(salted))
+		if id := GENERATED_ID_BASE + int(h.Sum64()%GENERATED_ID_RANGE); !taken(id) {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("stable IDs of %s are taken, %d salts tried", key, GENERATED_ID_SALTS)
 }
 
 func GetCoinByID(id int) *Coin {
@@ -56,12 +78,9 @@ func GetCoinID(code string) int {
 func GetCoin(code string) *Coin {
 	code = strings.TrimSpace(strings.ToUpper(code)) //trim for psql space
 
-	for _, id := range coinMap.Keys() {
-		if tmp, ok := coinMap.Get(id); ok {
-			c := tmp.(*Coin)
-			if c.Code == code {
-				return c
-			}
+	if tmp, ok := codeMap.Get(code); ok {
+		if c := tmp.(*Coin); c.Code == code {
+			return c
 		}
 	}
 	return nil
@@ -81,20 +100,84 @@ func GetCoins() []*Coin {
 	return coins
 }
 
-func AddCoin(coin *Coin) error {
-	if coin != nil && coin.Code != "" {
-		if coin.ID == 0 {
-			coin.ID = GenerateCoinID()
+// GetOrAddCoin - the coin already added with the code, eg: by another exchange initialized at the same time,
+// otherwise the coin itself added with a stable ID. nil if the code is empty, all its stable IDs are taken
+// or the coin added with the code is another asset, see SameAsset
+func GetOrAddCoin(coin *Coin) *Coin {
+	if coin == nil || coin.Code == "" {
+		return nil
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	coin.Code = strings.ToUpper(coin.Code)
+	if tmp, ok := codeMap.Get(coin.Code); ok {
+		if registered := tmp.(*Coin); SameAsset(registered, coin) {
+			return registered
+		}
+		return nil
+	}
+	if coin.ID == 0 {
+		id, err := GenerateStableID(coin.Code, func(id int) bool {
+			return coinMap.Has(fmt.Sprintf("%d", id))
+		})
+		if err != nil {
+			log.Printf("Add Coin %s Err: %v", coin.Code, err)
+			return nil
 		}
-		coin.Code = strings.ToUpper(coin.Code)
-		coinMap.Set(fmt.Sprintf("%d", coin.ID), coin)
-		LastID = coin.ID
-	} else {
+		coin.ID = id
+	}
+	setCoin(coin)
+	return coin
+}
+
+// SameAsset - false only for tokens on the same chain with different contracts,
+// a coin without contract can't be told apart, and a token is issued on several chains with a contract on each
+func SameAsset(a, b *Coin) bool {
+	if a.Contract == "" || b.Contract == "" || !strings.EqualFold(a.Chain, b.Chain) {
+		return true
+	}
+	return strings.EqualFold(a.Contract, b.Contract)
+}
+
+// AddCoin - adds the coin of the ID, replacing the one added before, see GetOrAddCoin for a coin without ID
+func AddCoin(coin *Coin) error {
+	if coin == nil || coin.Code == "" {
 		return errors.New("code is not assign yet")
+	} else if coin.ID == 0 {
+		return errors.New("ID is not assign yet")
 	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	coin.Code = strings.ToUpper(coin.Code)
+	setCoin(coin)
 	return nil
 }
 
+func setCoin(coin *Coin) {
+	key := fmt.Sprintf("%d", coin.ID)
+	if tmp, ok := coinMap.Get(key); ok {
+		removeCode(tmp.(*Coin))
+	}
+	coinMap.Set(key, coin)
+	codeMap.Set(coin.Code, coin)
+}
+
 func DeleteCoin(coin *Coin) {
-	coinMap.Remove(fmt.Sprintf("%d", coin.ID))
+	lock.Lock()
+	defer lock.Unlock()
+
+	key := fmt.Sprintf("%d", coin.ID)
+	if tmp, ok := coinMap.Get(key); ok {
+		removeCode(tmp.(*Coin))
+	}
+	coinMap.Remove(key)
+}
+
+// removeCode - drops the code index only if it still points to the coin
+func removeCode(coin *Coin) {
+	codeMap.RemoveCb(coin.Code, func(key string, v interface{}, exists bool) bool {
+		return exists && v.(*Coin).ID == coin.ID
+	})
 }
@@ -8,4 +8,11 @@ package coin
 // Base | Target  :   to  buy target use base, to sell target and get base
 const (
 	SEPARATOR = "|"
+
+	// the IDs of new coins and pairs are hashed into [GENERATED_ID_BASE, GENERATED_ID_BASE + GENERATED_ID_RANGE),
+	// so they don't depend on the order exchanges are initialized, the IDs below are the ones in data/common.json.
+	// The range fills a PSQL INTEGER, a key whose ID is taken is hashed again with up to GENERATED_ID_SALTS salts
+	GENERATED_ID_BASE  = 100000
+	GENERATED_ID_RANGE = 2147483647 - GENERATED_ID_BASE
+	GENERATED_ID_SALTS = 16
 )
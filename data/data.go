@@ -0,0 +1,13 @@
+package data
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import "embed"
+
+// FS - common.json and the <EXCHANGE>.json files of this directory bundled into the binary,
+// the default source of utils.GetCommonDataFromJSON and utils.GetExchangeDataFromJSON, regenerate them with go run . export
+//
+//go:embed *.json
+var FS embed.FS
@@ -0,0 +1,112 @@
+package exchange
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/bitontop/gored/coin"
+
+	cmap "github.com/orcaman/concurrent-map"
+)
+
+// Alias - a coin an exchange lists under another symbol, eg: BCHABC for BCH,
+// or a ticker that is a different asset on the exchange, eg: HOT on KuCoin is HOT2
+type Alias struct {
+	ExName ExchangeName `json:"exchange"`
+	Symbol string       `json:"symbol"`
+	Code   string       `json:"code"`
+}
+
+// ContractConflict - a coin refused by GetOrAddCoin, it has another contract than the coin added with the code,
+// the symbol needs an alias to a code of its own
+type ContractConflict struct {
+	ExName     ExchangeName
+	Symbol     string
+	Coin       *coin.Coin
+	Registered *coin.Coin
+}
+
+var aliasMap = cmap.New()    // exchange|symbol -> alias
+var conflictMap = cmap.New() // exchange|symbol -> contract conflict
+
+func getAliasKey(exName ExchangeName, symbol string) string {
+	return fmt.Sprintf("%s%s%s", exName, coin.SEPARATOR, strings.TrimSpace(strings.ToUpper(symbol)))
+}
+
+func SetAlias(alias *Alias) {
+	aliasMap.Set(getAliasKey(alias.ExName, alias.Symbol), alias)
+}
+
+func DeleteAlias(exName ExchangeName, symbol string) {
+	aliasMap.Remove(getAliasKey(exName, symbol))
+}
+
+func GetAlias(exName ExchangeName, symbol string) *Alias {
+	if tmp, ok := aliasMap.Get(getAliasKey(exName, symbol)); ok {
+		return tmp.(*Alias)
+	}
+	return nil
+}
+
+func GetAliases() []*Alias {
+	aliases := []*Alias{}
+	keySort := aliasMap.Keys()
+	sort.Strings(keySort)
+	for _, key := range keySort {
+		if tmp, ok := aliasMap.Get(key); ok {
+			aliases = append(aliases, tmp.(*Alias))
+		}
+	}
+	return aliases
+}
+
+// GetCoinCode - the coin code of an exchange symbol, the symbol itself if the exchange has no alias for it
+func GetCoinCode(exName ExchangeName, symbol string) string {
+	if alias := GetAlias(exName, symbol); alias != nil {
+		return alias.Code
+	}
+	return symbol
+}
+
+// GetCoin - the coin of an exchange symbol, adapters use it instead of coin.GetCoin when loading data from the exchange API
+func GetCoin(exName ExchangeName, symbol string) *coin.Coin {
+	return coin.GetCoin(GetCoinCode(exName, symbol))
+}
+
+// GetOrAddCoin - the coin of an exchange symbol, c is added with the code of the symbol if it's new.
+// nil if the coin added with the code is another asset, the conflict is kept for GetContractConflicts
+func GetOrAddCoin(exName ExchangeName, symbol string, c *coin.Coin) *coin.Coin {
+	c.Code = GetCoinCode(exName, symbol)
+	if registered := coin.GetOrAddCoin(c); registered != nil {
+		return registered
+	}
+
+	if registered := coin.GetCoin(c.Code); registered != nil && !coin.SameAsset(registered, c) {
+		log.Printf("%s %s Contract %s on %s, but coin %s(%d) is %s on %s, refused", exName, symbol, c.Contract, c.Chain, registered.Code, registered.ID, registered.Contract, registered.Chain)
+		conflictMap.Set(getAliasKey(exName, symbol), &ContractConflict{
+			ExName:     exName,
+			Symbol:     symbol,
+			Coin:       c,
+			Registered: registered,
+		})
+	}
+	return nil
+}
+
+func GetContractConflicts() []*ContractConflict {
+	conflicts := []*ContractConflict{}
+	keySort := conflictMap.Keys()
+	sort.Strings(keySort)
+	for _, key := range keySort {
+		if tmp, ok := conflictMap.Get(key); ok {
+			conflicts = append(conflicts, tmp.(*ContractConflict))
+		}
+	}
+	return conflicts
+}
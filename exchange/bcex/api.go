@@ -17,7 +17,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"time"
@@ -25,6 +24,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 
 	"strconv"
 )
@@ -79,11 +79,11 @@ func (e *Bcex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(key)
+			c = exchange.GetCoin(e.GetName(), key)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = key
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), key)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(key)
@@ -138,8 +138,8 @@ func (e *Bcex) GetPairsData() error {
 			pairSymbol := data.Token + data.Market
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(data.Market)
-				target := coin.GetCoin(data.Token)
+				base := exchange.GetCoin(e.GetName(), data.Market)
+				target := exchange.GetCoin(e.GetName(), data.Token)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -165,8 +165,8 @@ func (e *Bcex) GetPairsData() error {
 					ExSymbol:    pairSymbol,
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     math.Pow10(-1 * lotsize),
-					PriceFilter: math.Pow10(-1 * ticksize),
+					LotSize:     decimal.New(1, int32(-1*lotsize)),
+					PriceFilter: decimal.New(1, int32(-1*ticksize)),
 					Listed:      true,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -212,15 +212,15 @@ func (e *Bcex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		var buydata exchange.Order
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
-			log.Printf("BCEX Bids OrderBook strconv Rate error:%v", err)
+			log.Printf("BCEX Bids OrderBook decimal.NewFromString Rate error:%v", err)
 			return nil, err
 		}
 
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
-			log.Printf("BCEX Bids OrderBook strconv Quantity error:%v", err)
+			log.Printf("BCEX Bids OrderBook decimal.NewFromString Quantity error:%v", err)
 			return nil, err
 		}
 
@@ -228,15 +228,15 @@ func (e *Bcex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	}
 	for i := len(orderBook.Asks) - 1; i >= 0; i-- {
 		var selldata exchange.Order
-		selldata.Rate, err = strconv.ParseFloat(orderBook.Asks[i][0], 64)
+		selldata.Rate, err = decimal.NewFromString(orderBook.Asks[i][0])
 		if err != nil {
-			log.Printf("BCEX Asks OrderBook strconv Rate error:%v", err)
+			log.Printf("BCEX Asks OrderBook decimal.NewFromString Rate error:%v", err)
 			return nil, err
 		}
 
-		selldata.Quantity, err = strconv.ParseFloat(orderBook.Asks[i][1], 64)
+		selldata.Quantity, err = decimal.NewFromString(orderBook.Asks[i][1])
 		if err != nil {
-			log.Printf("BCEX Asks OrderBook strconv Quantity error:%v", err)
+			log.Printf("BCEX Asks OrderBook decimal.NewFromString Quantity error:%v", err)
 			return nil, err
 		}
 
@@ -318,14 +318,15 @@ func (e *Bcex) UpdateAllBalances() {
 				if err != nil {
 					log.Printf("%s parse balance Err: %v %s", e.GetName(), err, v.Usable)
 				}
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		}
 	}
 }
 
+// Withdraw - not viable, the market API (/api_market) only has balance and order endpoints, there is no withdraw endpoint
 func (e *Bcex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -334,6 +335,11 @@ func (e *Bcex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api_market/placeOrder"
@@ -343,8 +349,8 @@ func (e *Bcex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	mapParams["market"] = e.GetSymbolByCoin(pair.Base)
 	mapParams["token"] = e.GetSymbolByCoin(pair.Target)
 	mapParams["type"] = "2"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -359,8 +365,8 @@ func (e *Bcex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -374,6 +380,11 @@ func (e *Bcex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api_market/placeOrder"
@@ -383,8 +394,8 @@ func (e *Bcex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	mapParams["market"] = e.GetSymbolByCoin(pair.Base)
 	mapParams["token"] = e.GetSymbolByCoin(pair.Target)
 	mapParams["type"] = "1"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -399,8 +410,8 @@ func (e *Bcex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
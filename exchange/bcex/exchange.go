@@ -6,6 +6,7 @@ package bcex
 
 import (
 	"fmt"
+	"io/fs"
 	"log"
 	"sort"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/utils"
+	"github.com/shopspring/decimal"
 )
 
 type Bcex struct {
@@ -29,38 +31,42 @@ type Bcex struct {
 
 	Source    exchange.DataSource // / exchange API / microservicve api 1 / PSQL
 	SourceURI string
+	SourceFS  fs.FS
+
+	balanceMap cmap.ConcurrentMap
 }
 
-var pairConstraintMap cmap.ConcurrentMap
-var coinConstraintMap cmap.ConcurrentMap
-var balanceMap cmap.ConcurrentMap
+var constraints exchange.ConstraintStore // the coin and pair constraints, shared by the instances
 
-var instance *Bcex
 var once sync.Once
+var initErr error
 
 /***************************************************/
 func CreateBcex(config *exchange.Config) *Bcex {
-	once.Do(func() {
-		instance = &Bcex{
-			ID:      DEFAULT_ID,
-			Name:    "Bcex",
-			Website: "https://www.bcex.ca/",
-
-			API_KEY:    config.API_KEY, //"baf857298a8b06c66dea5213de37c076",
-			API_SECRET: config.API_SECRET,
-			Source:     config.Source,
-			SourceURI:  config.SourceURI,
-		}
+	instance := &Bcex{
+		ID:      DEFAULT_ID,
+		Name:    "Bcex",
+		Website: "https://www.bcex.ca/",
+
+		API_KEY:    config.API_KEY, //"baf857298a8b06c66dea5213de37c076",
+		API_SECRET: config.API_SECRET,
+		Source:     config.Source,
+		SourceURI:  config.SourceURI,
+		SourceFS:   config.SourceFS,
+	}
 
-		balanceMap = cmap.New()
-		coinConstraintMap = cmap.New()
-		pairConstraintMap = cmap.New()
+	instance.balanceMap = cmap.New()
 
-		if err := instance.InitData(); err != nil {
-			log.Printf("%v", err)
-			instance = nil
+	once.Do(func() {
+		constraints.Store(exchange.NewConstraintMaps())
+
+		if initErr = instance.InitData(); initErr != nil {
+			log.Printf("%v", initErr)
 		}
 	})
+	if initErr != nil {
+		return nil
+	}
 	return instance
 }
 
@@ -74,18 +80,13 @@ func (e *Bcex) InitData() error {
 			return err
 		}
 		break
-	case exchange.MICROSERVICE_API:
-		break
-	case exchange.JSON_FILE:
-		exchangeData := utils.GetExchangeDataFromJSON(e.SourceURI, e.GetName())
-		if exchangeData == nil {
-			return fmt.Errorf("%s Initial Data Error.", e.GetName())
-		} else {
-			coinConstraintMap = exchangeData.CoinConstraint
-			pairConstraintMap = exchangeData.PairConstraint
+	case exchange.MICROSERVICE_API, exchange.JSON_FILE, exchange.PSQL:
+		exchangeData, err := utils.GetExchangeData(e.Source, e.SourceURI, e.SourceFS, e.GetName())
+		if err != nil {
+			return err
 		}
+		constraints.Store(exchangeData)
 		break
-	case exchange.PSQL:
 	default:
 		return fmt.Errorf("%s Initial Coin: There is not selected data source.", e.GetName())
 	}
@@ -102,7 +103,7 @@ func (e *Bcex) GetName() exchange.ExchangeName {
 }
 
 func (e *Bcex) GetBalance(coin *coin.Coin) float64 {
-	if tmp, ok := balanceMap.Get(coin.Code); ok {
+	if tmp, ok := e.balanceMap.Get(coin.Code); ok {
 		return tmp.(float64)
 	} else {
 		return 0.0
@@ -115,20 +116,20 @@ func (e *Bcex) GetTradingWebURL(pair *pair.Pair) string {
 
 /*************** Coins on the Exchanges ***************/
 func (e *Bcex) GetCoinConstraint(coin *coin.Coin) *exchange.CoinConstraint {
-	if tmp, ok := coinConstraintMap.Get(fmt.Sprintf("%d", coin.ID)); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(fmt.Sprintf("%d", coin.ID)); ok {
 		return tmp.(*exchange.CoinConstraint)
 	}
 	return nil
 }
 
 func (e *Bcex) SetCoinConstraint(coinConstraint *exchange.CoinConstraint) {
-	coinConstraintMap.Set(fmt.Sprintf("%d", coinConstraint.CoinID), coinConstraint)
+	constraints.Load().SetCoinConstraint(coinConstraint)
 }
 
 func (e *Bcex) GetCoins() []*coin.Coin {
 	coinList := []*coin.Coin{}
 	keySort := []int{}
-	for _, key := range coinConstraintMap.Keys() {
+	for _, key := range constraints.Load().CoinConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -144,7 +145,7 @@ func (e *Bcex) GetCoins() []*coin.Coin {
 
 func (e *Bcex) GetSymbolByCoin(coin *coin.Coin) string {
 	key := fmt.Sprintf("%d", coin.ID)
-	if tmp, ok := coinConstraintMap.Get(key); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(key); ok {
 		cc := tmp.(*exchange.CoinConstraint)
 		return cc.ExSymbol
 	}
@@ -152,37 +153,32 @@ func (e *Bcex) GetSymbolByCoin(coin *coin.Coin) string {
 }
 
 func (e *Bcex) GetCoinBySymbol(symbol string) *coin.Coin {
-	for _, id := range coinConstraintMap.Keys() {
-		if tmp, ok := coinConstraintMap.Get(id); ok {
-			cc := tmp.(*exchange.CoinConstraint)
-			if cc.ExSymbol == symbol {
-				return cc.Coin
-			}
-		}
+	if tmp, ok := constraints.Load().CoinSymbol.Get(symbol); ok {
+		return tmp.(*exchange.CoinConstraint).Coin
 	}
 	return nil
 }
 
 func (e *Bcex) DeleteCoin(coin *coin.Coin) {
-	coinConstraintMap.Remove(fmt.Sprintf("%d", coin.ID))
+	constraints.Load().DeleteCoinConstraint(coin.ID)
 }
 
 /*************** Pairs on the Exchanges ***************/
 func (e *Bcex) GetPairConstraint(pair *pair.Pair) *exchange.PairConstraint {
-	if tmp, ok := pairConstraintMap.Get(fmt.Sprintf("%d", pair.ID)); ok {
+	if tmp, ok := constraints.Load().PairConstraint.Get(fmt.Sprintf("%d", pair.ID)); ok {
 		return tmp.(*exchange.PairConstraint)
 	}
 	return nil
 }
 
 func (e *Bcex) SetPairConstraint(pairConstraint *exchange.PairConstraint) {
-	pairConstraintMap.Set(fmt.Sprintf("%d", pairConstraint.PairID), pairConstraint)
+	constraints.Load().SetPairConstraint(pairConstraint)
 }
 
 func (e *Bcex) GetPairs() []*pair.Pair {
 	pairList := []*pair.Pair{}
 	keySort := []int{}
-	for _, key := range pairConstraintMap.Keys() {
+	for _, key := range constraints.Load().PairConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -197,13 +193,8 @@ func (e *Bcex) GetPairs() []*pair.Pair {
 }
 
 func (e *Bcex) GetPairBySymbol(symbol string) *pair.Pair {
-	for _, id := range pairConstraintMap.Keys() {
-		if tmp, ok := pairConstraintMap.Get(id); ok {
-			pc := tmp.(*exchange.PairConstraint)
-			if pc.ExSymbol == symbol {
-				return pc.Pair
-			}
-		}
+	if tmp, ok := constraints.Load().PairSymbol.Get(symbol); ok {
+		return tmp.(*exchange.PairConstraint).Pair
 	}
 	return nil
 }
@@ -217,11 +208,11 @@ func (e *Bcex) GetSymbolByPair(pair *pair.Pair) string {
 }
 
 func (e *Bcex) HasPair(pair *pair.Pair) bool {
-	return pairConstraintMap.Has(fmt.Sprintf("%d", pair.ID))
+	return constraints.Load().PairConstraint.Has(fmt.Sprintf("%d", pair.ID))
 }
 
 func (e *Bcex) DeletePair(pair *pair.Pair) {
-	pairConstraintMap.Remove(fmt.Sprintf("%d", pair.ID))
+	constraints.Load().DeletePairConstraint(pair.ID)
 }
 
 /**************** Exchange Constraint ****************/
@@ -230,7 +221,7 @@ func (e *Bcex) GetConstraintFetchMethod(pair *pair.Pair) *exchange.ConstrainFetc
 	constrainFetchMethod.PublicAPI = true
 	constrainFetchMethod.PrivateAPI = true
 	constrainFetchMethod.HealthAPI = true
-	constrainFetchMethod.HasWithdraw = false
+	constrainFetchMethod.HasWithdraw = false // no withdraw endpoint, see Withdraw
 	constrainFetchMethod.Fee = false
 	constrainFetchMethod.LotSize = true
 	constrainFetchMethod.PriceFilter = true
@@ -288,18 +279,18 @@ func (e *Bcex) GetFee(pair *pair.Pair) float64 {
 	return pairConstraint.TakerFee
 }
 
-func (e *Bcex) GetLotSize(pair *pair.Pair) float64 {
+func (e *Bcex) GetLotSize(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.LotSize
 }
 
-func (e *Bcex) GetPriceFilter(pair *pair.Pair) float64 {
+func (e *Bcex) GetPriceFilter(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.PriceFilter
 }
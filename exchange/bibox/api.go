@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -78,11 +79,11 @@ func (e *Bibox) GetCoinsData() error {
 			c := &coin.Coin{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				c = coin.GetCoin(data.CoinSymbol)
+				c = exchange.GetCoin(e.GetName(), data.CoinSymbol)
 				if c == nil {
 					c = &coin.Coin{}
-					c.Code = data.CoinSymbol
-					coin.AddCoin(c)
+					c.Code = exchange.GetCoinCode(e.GetName(), data.CoinSymbol)
+					c = coin.GetOrAddCoin(c)
 				}
 			case exchange.JSON_FILE:
 				c = e.GetCoinBySymbol(data.CoinSymbol)
@@ -151,8 +152,8 @@ func (e *Bibox) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(pairStrs[1])
-			target := coin.GetCoin(pairStrs[0])
+			base := exchange.GetCoin(e.GetName(), pairStrs[1])
+			target := exchange.GetCoin(e.GetName(), pairStrs[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -167,13 +168,13 @@ func (e *Bibox) GetPairsData() error {
 				ExSymbol:    data.Pair,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 				Listed:      true,
 			}
 
 			if pairStrs[1] == "USDT" || pairStrs[1] == "DAI" {
-				pairConstraint.PriceFilter = USDT_PRICE_FILTER
+				pairConstraint.PriceFilter = decimal.NewFromFloat(USDT_PRICE_FILTER)
 			}
 
 			e.SetPairConstraint(pairConstraint)
@@ -221,11 +222,11 @@ func (e *Bibox) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Volume, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Volume)
 		if err != nil {
 			return nil, err
 		}
@@ -235,11 +236,11 @@ func (e *Bibox) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, ask := range orderBook.Asks {
 		var selldata exchange.Order
 
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Volume, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Volume)
 		if err != nil {
 			return nil, err
 		}
@@ -290,7 +291,7 @@ func (e *Bibox) UpdateAllBalances() {
 			if err == nil {
 				c := e.GetCoinBySymbol(v.CoinSymbol)
 				if c != nil {
-					balanceMap.Set(c.Code, freeamount)
+					e.balanceMap.Set(c.Code, freeamount)
 				}
 			} else {
 				log.Printf("%s %s Get Balance Err: %s\n", e.GetName(), v.CoinSymbol, err)
@@ -357,6 +358,11 @@ func (e *Bibox) Withdraw(coin *coin.Coin, quantity float64, addr, tag string /*
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := JsonResponse{}
 	withdraw := Withdraw{}
 
@@ -397,6 +403,11 @@ func (e *Bibox) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/orderpending"
@@ -409,8 +420,8 @@ func (e *Bibox) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	body["account_type"] = 0
 	body["order_type"] = 2
 	body["order_side"] = 2
-	body["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	body["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	body["price"] = orderRate.String()
+	body["amount"] = orderQuantity.String()
 
 	mapParams["body"] = body
 
@@ -427,8 +438,8 @@ func (e *Bibox) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder[0].Result,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -442,6 +453,11 @@ func (e *Bibox) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/orderpending"
@@ -454,8 +470,8 @@ func (e *Bibox) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	body["account_type"] = 0
 	body["order_type"] = 2
 	body["order_side"] = 1
-	body["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	body["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	body["price"] = orderRate.String()
+	body["amount"] = orderQuantity.String()
 
 	mapParams["body"] = body
 
@@ -472,8 +488,8 @@ func (e *Bibox) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder[0].Result,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
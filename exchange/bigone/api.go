@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -19,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -72,19 +72,19 @@ func (e *Bigone) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.QuoteAsset.Symbol)
+			base = exchange.GetCoin(e.GetName(), data.QuoteAsset.Symbol)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.QuoteAsset.Symbol
+				base.Code = exchange.GetCoinCode(e.GetName(), data.QuoteAsset.Symbol)
 				base.Name = data.QuoteAsset.Name
-				coin.AddCoin(base)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseAsset.Symbol)
+			target = exchange.GetCoin(e.GetName(), data.BaseAsset.Symbol)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseAsset.Symbol
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseAsset.Symbol)
 				target.Name = data.BaseAsset.Name
-				coin.AddCoin(target)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.QuoteAsset.Symbol)
@@ -149,8 +149,8 @@ func (e *Bigone) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteAsset.Symbol)
-			target := coin.GetCoin(data.BaseAsset.Symbol)
+			base := exchange.GetCoin(e.GetName(), data.QuoteAsset.Symbol)
+			target := exchange.GetCoin(e.GetName(), data.BaseAsset.Symbol)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -164,8 +164,8 @@ func (e *Bigone) GetPairsData() error {
 				ExSymbol:    data.Name,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.BaseScale),
-				PriceFilter: math.Pow10(-1 * data.QuoteScale),
+				LotSize:     decimal.New(1, int32(-1*data.BaseScale)),
+				PriceFilter: decimal.New(1, int32(-1*data.QuoteScale)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -209,11 +209,11 @@ func (e *Bigone) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Amount, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -224,11 +224,11 @@ func (e *Bigone) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Amount, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -267,7 +267,7 @@ func (e *Bigone) UpdateAllBalances() {
 		if c != nil {
 			balance, err := strconv.ParseFloat(v.Balance, 64)
 			if err == nil {
-				balanceMap.Set(c.Code, balance)
+				e.balanceMap.Set(c.Code, balance)
 			} else {
 				log.Printf("%s balance float64 convert err: %v", err)
 				return
@@ -284,6 +284,11 @@ func (e *Bigone) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := JsonResponse{}
 	withdraw := Withdraw{}
 
@@ -316,16 +321,18 @@ func (e *Bigone) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/viewer/orders"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["side"] = "ASK"
 	mapParams["market_id"] = e.GetSymbolByPair(pair)
 
@@ -342,8 +349,8 @@ func (e *Bigone) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.ID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -357,16 +364,18 @@ func (e *Bigone) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/viewer/orders"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["side"] = "BID"
 	mapParams["market_id"] = e.GetSymbolByPair(pair)
 
@@ -383,8 +392,8 @@ func (e *Bigone) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.ID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -418,9 +427,9 @@ func (e *Bigone) OrderStatus(order *exchange.Order) error {
 		log.Printf("Bigone order statuse parse filled amount to float64 error: %v  %v", err, orderStatus.FilledAmount)
 		filledF = 0.0
 	}
-	if filledF == order.Quantity {
+	if filledF == order.Quantity.InexactFloat64() {
 		order.Status = exchange.Filled
-	} else if filledF > 0 && filledF < order.Quantity {
+	} else if filledF > 0 && filledF < order.Quantity.InexactFloat64() {
 		order.Status = exchange.Partial
 	} else if filledF == 0 {
 		order.Status = exchange.New
@@ -6,6 +6,7 @@ package bigone
 
 import (
 	"fmt"
+	"io/fs"
 	"log"
 	"sort"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/utils"
+	"github.com/shopspring/decimal"
 )
 
 type Bigone struct {
@@ -29,38 +31,42 @@ type Bigone struct {
 
 	Source    exchange.DataSource // / exchange API / microservicve api 1 / PSQL
 	SourceURI string
+	SourceFS  fs.FS
+
+	balanceMap cmap.ConcurrentMap
 }
 
-var pairConstraintMap cmap.ConcurrentMap
-var coinConstraintMap cmap.ConcurrentMap
-var balanceMap cmap.ConcurrentMap
+var constraints exchange.ConstraintStore // the coin and pair constraints, shared by the instances
 
-var instance *Bigone
 var once sync.Once
+var initErr error
 
 /***************************************************/
 func CreateBigone(config *exchange.Config) *Bigone {
-	once.Do(func() {
-		instance = &Bigone{
-			ID:      DEFAULT_ID,
-			Name:    "Bigone",
-			Website: "https://www.bigone.com/",
-
-			API_KEY:    config.API_KEY,
-			API_SECRET: config.API_SECRET,
-			Source:     config.Source,
-			SourceURI:  config.SourceURI,
-		}
+	instance := &Bigone{
+		ID:      DEFAULT_ID,
+		Name:    "Bigone",
+		Website: "https://www.bigone.com/",
+
+		API_KEY:    config.API_KEY,
+		API_SECRET: config.API_SECRET,
+		Source:     config.Source,
+		SourceURI:  config.SourceURI,
+		SourceFS:   config.SourceFS,
+	}
 
-		balanceMap = cmap.New()
-		coinConstraintMap = cmap.New()
-		pairConstraintMap = cmap.New()
+	instance.balanceMap = cmap.New()
 
-		if err := instance.InitData(); err != nil {
-			log.Printf("%v", err)
-			instance = nil
+	once.Do(func() {
+		constraints.Store(exchange.NewConstraintMaps())
+
+		if initErr = instance.InitData(); initErr != nil {
+			log.Printf("%v", initErr)
 		}
 	})
+	if initErr != nil {
+		return nil
+	}
 	return instance
 }
 
@@ -74,18 +80,13 @@ func (e *Bigone) InitData() error {
 			return err
 		}
 		break
-	case exchange.MICROSERVICE_API:
-		break
-	case exchange.JSON_FILE:
-		exchangeData := utils.GetExchangeDataFromJSON(e.SourceURI, e.GetName())
-		if exchangeData == nil {
-			return fmt.Errorf("%s Initial Data Error.", e.GetName())
-		} else {
-			coinConstraintMap = exchangeData.CoinConstraint
-			pairConstraintMap = exchangeData.PairConstraint
+	case exchange.MICROSERVICE_API, exchange.JSON_FILE, exchange.PSQL:
+		exchangeData, err := utils.GetExchangeData(e.Source, e.SourceURI, e.SourceFS, e.GetName())
+		if err != nil {
+			return err
 		}
+		constraints.Store(exchangeData)
 		break
-	case exchange.PSQL:
 	default:
 		return fmt.Errorf("%s Initial Coin: There is not selected data source.", e.GetName())
 	}
@@ -102,7 +103,7 @@ func (e *Bigone) GetName() exchange.ExchangeName {
 }
 
 func (e *Bigone) GetBalance(coin *coin.Coin) float64 {
-	if tmp, ok := balanceMap.Get(coin.Code); ok {
+	if tmp, ok := e.balanceMap.Get(coin.Code); ok {
 		return tmp.(float64)
 	} else {
 		return 0.0
@@ -115,20 +116,20 @@ func (e *Bigone) GetTradingWebURL(pair *pair.Pair) string {
 
 /*************** Coins on the Exchanges ***************/
 func (e *Bigone) GetCoinConstraint(coin *coin.Coin) *exchange.CoinConstraint {
-	if tmp, ok := coinConstraintMap.Get(fmt.Sprintf("%d", coin.ID)); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(fmt.Sprintf("%d", coin.ID)); ok {
 		return tmp.(*exchange.CoinConstraint)
 	}
 	return nil
 }
 
 func (e *Bigone) SetCoinConstraint(coinConstraint *exchange.CoinConstraint) {
-	coinConstraintMap.Set(fmt.Sprintf("%d", coinConstraint.CoinID), coinConstraint)
+	constraints.Load().SetCoinConstraint(coinConstraint)
 }
 
 func (e *Bigone) GetCoins() []*coin.Coin {
 	coinList := []*coin.Coin{}
 	keySort := []int{}
-	for _, key := range coinConstraintMap.Keys() {
+	for _, key := range constraints.Load().CoinConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -144,7 +145,7 @@ func (e *Bigone) GetCoins() []*coin.Coin {
 
 func (e *Bigone) GetSymbolByCoin(coin *coin.Coin) string {
 	key := fmt.Sprintf("%d", coin.ID)
-	if tmp, ok := coinConstraintMap.Get(key); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(key); ok {
 		cc := tmp.(*exchange.CoinConstraint)
 		return cc.ExSymbol
 	}
@@ -152,37 +153,32 @@ func (e *Bigone) GetSymbolByCoin(coin *coin.Coin) string {
 }
 
 func (e *Bigone) GetCoinBySymbol(symbol string) *coin.Coin {
-	for _, id := range coinConstraintMap.Keys() {
-		if tmp, ok := coinConstraintMap.Get(id); ok {
-			cc := tmp.(*exchange.CoinConstraint)
-			if cc.ExSymbol == symbol {
-				return cc.Coin
-			}
-		}
+	if tmp, ok := constraints.Load().CoinSymbol.Get(symbol); ok {
+		return tmp.(*exchange.CoinConstraint).Coin
 	}
 	return nil
 }
 
 func (e *Bigone) DeleteCoin(coin *coin.Coin) {
-	coinConstraintMap.Remove(fmt.Sprintf("%d", coin.ID))
+	constraints.Load().DeleteCoinConstraint(coin.ID)
 }
 
 /*************** Pairs on the Exchanges ***************/
 func (e *Bigone) GetPairConstraint(pair *pair.Pair) *exchange.PairConstraint {
-	if tmp, ok := pairConstraintMap.Get(fmt.Sprintf("%d", pair.ID)); ok {
+	if tmp, ok := constraints.Load().PairConstraint.Get(fmt.Sprintf("%d", pair.ID)); ok {
 		return tmp.(*exchange.PairConstraint)
 	}
 	return nil
 }
 
 func (e *Bigone) SetPairConstraint(pairConstraint *exchange.PairConstraint) {
-	pairConstraintMap.Set(fmt.Sprintf("%d", pairConstraint.PairID), pairConstraint)
+	constraints.Load().SetPairConstraint(pairConstraint)
 }
 
 func (e *Bigone) GetPairs() []*pair.Pair {
 	pairList := []*pair.Pair{}
 	keySort := []int{}
-	for _, key := range pairConstraintMap.Keys() {
+	for _, key := range constraints.Load().PairConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -197,13 +193,8 @@ func (e *Bigone) GetPairs() []*pair.Pair {
 }
 
 func (e *Bigone) GetPairBySymbol(symbol string) *pair.Pair {
-	for _, id := range pairConstraintMap.Keys() {
-		if tmp, ok := pairConstraintMap.Get(id); ok {
-			pc := tmp.(*exchange.PairConstraint)
-			if pc.ExSymbol == symbol {
-				return pc.Pair
-			}
-		}
+	if tmp, ok := constraints.Load().PairSymbol.Get(symbol); ok {
+		return tmp.(*exchange.PairConstraint).Pair
 	}
 	return nil
 }
@@ -217,11 +208,11 @@ func (e *Bigone) GetSymbolByPair(pair *pair.Pair) string {
 }
 
 func (e *Bigone) HasPair(pair *pair.Pair) bool {
-	return pairConstraintMap.Has(fmt.Sprintf("%d", pair.ID))
+	return constraints.Load().PairConstraint.Has(fmt.Sprintf("%d", pair.ID))
 }
 
 func (e *Bigone) DeletePair(pair *pair.Pair) {
-	pairConstraintMap.Remove(fmt.Sprintf("%d", pair.ID))
+	constraints.Load().DeletePairConstraint(pair.ID)
 }
 
 /**************** Exchange Constraint ****************/
@@ -288,18 +279,18 @@ func (e *Bigone) GetFee(pair *pair.Pair) float64 {
 	return pairConstraint.TakerFee
 }
 
-func (e *Bigone) GetLotSize(pair *pair.Pair) float64 {
+func (e *Bigone) GetLotSize(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.LotSize
 }
 
-func (e *Bigone) GetPriceFilter(pair *pair.Pair) float64 {
+func (e *Bigone) GetPriceFilter(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.PriceFilter
 }
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"sort"
@@ -20,6 +19,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -73,17 +73,17 @@ func (e *Biki) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.CountCoin)
+			base = exchange.GetCoin(e.GetName(), data.CountCoin)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.CountCoin
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.CountCoin)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseCoin)
+			target = exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseCoin
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseCoin)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.CountCoin)
@@ -148,8 +148,8 @@ func (e *Biki) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.CountCoin)
-			target := coin.GetCoin(data.BaseCoin)
+			base := exchange.GetCoin(e.GetName(), data.CountCoin)
+			target := exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -163,8 +163,8 @@ func (e *Biki) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.AmountPrecision),
-				PriceFilter: math.Pow10(-1 * data.PricePrecision),
+				LotSize:     decimal.New(1, int32(-1*data.AmountPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.PricePrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -212,11 +212,11 @@ func (e *Biki) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate = bid[0]
+		buydata.Rate = decimal.NewFromFloat(bid[0])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity = bid[1]
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
 		if err != nil {
 			return nil, err
 		}
@@ -227,11 +227,11 @@ func (e *Biki) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate = ask[0]
+		selldata.Rate = decimal.NewFromFloat(ask[0])
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity = ask[1]
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
 		if err != nil {
 			return nil, err
 		}
@@ -273,13 +273,14 @@ func (e *Biki) UpdateAllBalances() {
 				log.Printf("%s Balance parse error: %v, %v", e.GetName(), err, v.Normal)
 				return
 			}
-			balanceMap.Set(c.Code, freeamount)
+			e.balanceMap.Set(c.Code, freeamount)
 		}
 	}
 }
 
+// Withdraw - not viable, the open API (/open/api) only has account and order endpoints, there is no withdraw endpoint
 func (e *Biki) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -288,19 +289,21 @@ func (e *Biki) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/open/api/create_order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "1"
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["volume"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -315,8 +318,8 @@ func (e *Biki) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -330,19 +333,21 @@ func (e *Biki) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/open/api/create_order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "1"
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["volume"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -357,8 +362,8 @@ func (e *Biki) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -6,6 +6,7 @@ package biki
 
 import (
 	"fmt"
+	"io/fs"
 	"log"
 	"sort"
 	"strconv"
@@ -18,6 +19,7 @@ import (
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/utils"
+	"github.com/shopspring/decimal"
 )
 
 type Biki struct {
@@ -30,38 +32,42 @@ type Biki struct {
 
 	Source    exchange.DataSource // / exchange API / microservicve api 1 / PSQL
 	SourceURI string
+	SourceFS  fs.FS
+
+	balanceMap cmap.ConcurrentMap
 }
 
-var pairConstraintMap cmap.ConcurrentMap
-var coinConstraintMap cmap.ConcurrentMap
-var balanceMap cmap.ConcurrentMap
+var constraints exchange.ConstraintStore // the coin and pair constraints, shared by the instances
 
-var instance *Biki
 var once sync.Once
+var initErr error
 
 /***************************************************/
 func CreateBiki(config *exchange.Config) *Biki {
-	once.Do(func() {
-		instance = &Biki{
-			ID:      DEFAULT_ID,
-			Name:    "Biki",
-			Website: "https://www.biki.com/",
-
-			API_KEY:    config.API_KEY,
-			API_SECRET: config.API_SECRET,
-			Source:     config.Source,
-			SourceURI:  config.SourceURI,
-		}
+	instance := &Biki{
+		ID:      DEFAULT_ID,
+		Name:    "Biki",
+		Website: "https://www.biki.com/",
+
+		API_KEY:    config.API_KEY,
+		API_SECRET: config.API_SECRET,
+		Source:     config.Source,
+		SourceURI:  config.SourceURI,
+		SourceFS:   config.SourceFS,
+	}
 
-		balanceMap = cmap.New()
-		coinConstraintMap = cmap.New()
-		pairConstraintMap = cmap.New()
+	instance.balanceMap = cmap.New()
 
-		if err := instance.InitData(); err != nil {
-			log.Printf("%v", err)
-			instance = nil
+	once.Do(func() {
+		constraints.Store(exchange.NewConstraintMaps())
+
+		if initErr = instance.InitData(); initErr != nil {
+			log.Printf("%v", initErr)
 		}
 	})
+	if initErr != nil {
+		return nil
+	}
 	return instance
 }
 
@@ -75,18 +81,13 @@ func (e *Biki) InitData() error {
 			return err
 		}
 		break
-	case exchange.MICROSERVICE_API:
-		break
-	case exchange.JSON_FILE:
-		exchangeData := utils.GetExchangeDataFromJSON(e.SourceURI, e.GetName())
-		if exchangeData == nil {
-			return fmt.Errorf("%s Initial Data Error.", e.GetName())
-		} else {
-			coinConstraintMap = exchangeData.CoinConstraint
-			pairConstraintMap = exchangeData.PairConstraint
+	case exchange.MICROSERVICE_API, exchange.JSON_FILE, exchange.PSQL:
+		exchangeData, err := utils.GetExchangeData(e.Source, e.SourceURI, e.SourceFS, e.GetName())
+		if err != nil {
+			return err
 		}
+		constraints.Store(exchangeData)
 		break
-	case exchange.PSQL:
 	default:
 		return fmt.Errorf("%s Initial Coin: There is not selected data source.", e.GetName())
 	}
@@ -103,7 +104,7 @@ func (e *Biki) GetName() exchange.ExchangeName {
 }
 
 func (e *Biki) GetBalance(coin *coin.Coin) float64 {
-	if tmp, ok := balanceMap.Get(coin.Code); ok {
+	if tmp, ok := e.balanceMap.Get(coin.Code); ok {
 		return tmp.(float64)
 	} else {
 		return 0.0
@@ -116,20 +117,20 @@ func (e *Biki) GetTradingWebURL(pair *pair.Pair) string {
 
 /*************** Coins on the Exchanges ***************/
 func (e *Biki) GetCoinConstraint(coin *coin.Coin) *exchange.CoinConstraint {
-	if tmp, ok := coinConstraintMap.Get(fmt.Sprintf("%d", coin.ID)); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(fmt.Sprintf("%d", coin.ID)); ok {
 		return tmp.(*exchange.CoinConstraint)
 	}
 	return nil
 }
 
 func (e *Biki) SetCoinConstraint(coinConstraint *exchange.CoinConstraint) {
-	coinConstraintMap.Set(fmt.Sprintf("%d", coinConstraint.CoinID), coinConstraint)
+	constraints.Load().SetCoinConstraint(coinConstraint)
 }
 
 func (e *Biki) GetCoins() []*coin.Coin {
 	coinList := []*coin.Coin{}
 	keySort := []int{}
-	for _, key := range coinConstraintMap.Keys() {
+	for _, key := range constraints.Load().CoinConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -145,7 +146,7 @@ func (e *Biki) GetCoins() []*coin.Coin {
 
 func (e *Biki) GetSymbolByCoin(coin *coin.Coin) string {
 	key := fmt.Sprintf("%d", coin.ID)
-	if tmp, ok := coinConstraintMap.Get(key); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(key); ok {
 		cc := tmp.(*exchange.CoinConstraint)
 		return cc.ExSymbol
 	}
@@ -153,37 +154,32 @@ func (e *Biki) GetSymbolByCoin(coin *coin.Coin) string {
 }
 
 func (e *Biki) GetCoinBySymbol(symbol string) *coin.Coin {
-	for _, id := range coinConstraintMap.Keys() {
-		if tmp, ok := coinConstraintMap.Get(id); ok {
-			cc := tmp.(*exchange.CoinConstraint)
-			if cc.ExSymbol == symbol {
-				return cc.Coin
-			}
-		}
+	if tmp, ok := constraints.Load().CoinSymbol.Get(symbol); ok {
+		return tmp.(*exchange.CoinConstraint).Coin
 	}
 	return nil
 }
 
 func (e *Biki) DeleteCoin(coin *coin.Coin) {
-	coinConstraintMap.Remove(fmt.Sprintf("%d", coin.ID))
+	constraints.Load().DeleteCoinConstraint(coin.ID)
 }
 
 /*************** Pairs on the Exchanges ***************/
 func (e *Biki) GetPairConstraint(pair *pair.Pair) *exchange.PairConstraint {
-	if tmp, ok := pairConstraintMap.Get(fmt.Sprintf("%d", pair.ID)); ok {
+	if tmp, ok := constraints.Load().PairConstraint.Get(fmt.Sprintf("%d", pair.ID)); ok {
 		return tmp.(*exchange.PairConstraint)
 	}
 	return nil
 }
 
 func (e *Biki) SetPairConstraint(pairConstraint *exchange.PairConstraint) {
-	pairConstraintMap.Set(fmt.Sprintf("%d", pairConstraint.PairID), pairConstraint)
+	constraints.Load().SetPairConstraint(pairConstraint)
 }
 
 func (e *Biki) GetPairs() []*pair.Pair {
 	pairList := []*pair.Pair{}
 	keySort := []int{}
-	for _, key := range pairConstraintMap.Keys() {
+	for _, key := range constraints.Load().PairConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -198,13 +194,8 @@ func (e *Biki) GetPairs() []*pair.Pair {
 }
 
 func (e *Biki) GetPairBySymbol(symbol string) *pair.Pair {
-	for _, id := range pairConstraintMap.Keys() {
-		if tmp, ok := pairConstraintMap.Get(id); ok {
-			pc := tmp.(*exchange.PairConstraint)
-			if pc.ExSymbol == symbol {
-				return pc.Pair
-			}
-		}
+	if tmp, ok := constraints.Load().PairSymbol.Get(symbol); ok {
+		return tmp.(*exchange.PairConstraint).Pair
 	}
 	return nil
 }
@@ -218,11 +209,11 @@ func (e *Biki) GetSymbolByPair(pair *pair.Pair) string {
 }
 
 func (e *Biki) HasPair(pair *pair.Pair) bool {
-	return pairConstraintMap.Has(fmt.Sprintf("%d", pair.ID))
+	return constraints.Load().PairConstraint.Has(fmt.Sprintf("%d", pair.ID))
 }
 
 func (e *Biki) DeletePair(pair *pair.Pair) {
-	pairConstraintMap.Remove(fmt.Sprintf("%d", pair.ID))
+	constraints.Load().DeletePairConstraint(pair.ID)
 }
 
 /**************** Exchange Constraint ****************/
@@ -231,7 +222,7 @@ func (e *Biki) GetConstraintFetchMethod(pair *pair.Pair) *exchange.ConstrainFetc
 	constrainFetchMethod.PublicAPI = true
 	constrainFetchMethod.PrivateAPI = true
 	constrainFetchMethod.HealthAPI = true
-	constrainFetchMethod.HasWithdraw = true
+	constrainFetchMethod.HasWithdraw = false // no withdraw endpoint, see Withdraw
 	constrainFetchMethod.Fee = false
 	constrainFetchMethod.LotSize = true
 	constrainFetchMethod.PriceFilter = true
@@ -289,18 +280,18 @@ func (e *Biki) GetFee(pair *pair.Pair) float64 {
 	return pairConstraint.TakerFee
 }
 
-func (e *Biki) GetLotSize(pair *pair.Pair) float64 {
+func (e *Biki) GetLotSize(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.LotSize
 }
 
-func (e *Biki) GetPriceFilter(pair *pair.Pair) float64 {
+func (e *Biki) GetPriceFilter(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.PriceFilter
 }
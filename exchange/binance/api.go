@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -51,52 +51,151 @@ Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
 Step 3: Modify API Path(strRequestUrl)*/
 func (e *Binance) GetCoinsData() error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return e.getPublicCoinsData()
+	}
+
+	errResponse := ErrorResponse{}
 	coinsData := CoinsData{}
 
-	strUrl := "https://www.binance.com/assetWithdraw/getAllAsset.html"
+	strRequestUrl := "/sapi/v1/capital/config/getall"
 
-	jsonCurrencyReturn := exchange.HttpGetRequest(strUrl, nil)
+	jsonCurrencyReturn := e.ApiKeyGet(make(map[string]string), strRequestUrl)
+	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		log.Printf("%s Get Coins Failed, fall back to public data: %v %v", e.GetName(), errResponse.Code, errResponse.Msg)
+		return e.getPublicCoinsData()
+	}
 	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &coinsData); err != nil {
 		return fmt.Errorf("%s Get Coins Json Unmarshal Err: %v %v", e.GetName(), err, jsonCurrencyReturn)
 	}
 
 	for _, data := range coinsData {
+		// a token is told apart by the contract of its default network
+		chain, contract := "", ""
+		for _, network := range data.NetworkList {
+			if network.IsDefault && network.ContractAddress != "" {
+				chain, contract = network.Network, network.ContractAddress
+			}
+		}
+
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.AssetCode)
-			if c == nil {
-				c = &coin.Coin{}
-				c.Code = data.AssetCode
-				c.Name = data.AssetName
-				c.Website = data.URL
-				c.Explorer = data.BlockURL
-				coin.AddCoin(c)
-			}
+			c = exchange.GetOrAddCoin(e.GetName(), data.Coin, &coin.Coin{
+				Name:     data.Name,
+				Chain:    chain,
+				Contract: contract,
+			})
 		case exchange.JSON_FILE:
-			c = e.GetCoinBySymbol(data.AssetCode)
+			c = e.GetCoinBySymbol(data.Coin)
 		}
 
 		if c != nil {
-			confirmation, _ := strconv.Atoi(data.ConfirmTimes)
 			coinConstraint := &exchange.CoinConstraint{
 				CoinID:       c.ID,
 				Coin:         c,
-				ExSymbol:     data.AssetCode,
+				ExSymbol:     data.Coin,
 				ChainType:    exchange.MAINNET,
-				TxFee:        data.TransactionFee,
-				Withdraw:     data.EnableWithdraw,
-				Deposit:      data.EnableCharge,
-				Confirmation: confirmation,
+				TxFee:        DEFAULT_TXFEE,
+				Withdraw:     data.WithdrawAllEnable,
+				Deposit:      data.DepositAllEnable,
+				Confirmation: DEFAULT_CONFIRMATION,
 				Listed:       true,
 			}
 
+			// the default network is the one used by Withdraw without a network param
+			for _, network := range data.NetworkList {
+				if !network.IsDefault {
+					continue
+				}
+				coinConstraint.ChainType = getChainType(data.Coin, network.Network)
+				coinConstraint.Contract = network.ContractAddress
+				coinConstraint.TxFee, _ = strconv.ParseFloat(network.WithdrawFee, 64)
+				coinConstraint.MinWithdraw, _ = strconv.ParseFloat(network.WithdrawMin, 64)
+				coinConstraint.WithdrawStep, _ = strconv.ParseFloat(network.WithdrawIntegerMultiple, 64)
+				coinConstraint.Withdraw = network.WithdrawEnable
+				coinConstraint.Deposit = network.DepositEnable
+				coinConstraint.Confirmation = network.MinConfirm
+				if !network.WithdrawEnable && network.WithdrawDesc != "" {
+					coinConstraint.Issue = network.WithdrawDesc
+				} else if !network.DepositEnable && network.DepositDesc != "" {
+					coinConstraint.Issue = network.DepositDesc
+				}
+				break
+			}
+
 			e.SetCoinConstraint(coinConstraint)
 		}
 	}
 	return nil
 }
 
+/* getPublicCoinsData - without API Key the coins come from the traded assets with default constraints */
+func (e *Binance) getPublicCoinsData() error {
+	pairsData := &PairsData{}
+
+	strRequestUrl := "/api/v1/exchangeInfo"
+	strUrl := API_URL + strRequestUrl
+
+	jsonSymbolsReturn := exchange.HttpGetRequest(strUrl, nil)
+	if err := json.Unmarshal([]byte(jsonSymbolsReturn), &pairsData); err != nil {
+		return fmt.Errorf("%s Get Coins Json Unmarshal Err: %v %v", e.GetName(), err, jsonSymbolsReturn)
+	}
+
+	for _, data := range pairsData.Symbols {
+		for _, symbol := range []string{data.BaseAsset, data.QuoteAsset} {
+			c := &coin.Coin{}
+			switch e.Source {
+			case exchange.EXCHANGE_API:
+				c = exchange.GetCoin(e.GetName(), symbol)
+				if c == nil {
+					c = &coin.Coin{}
+					c.Code = exchange.GetCoinCode(e.GetName(), symbol)
+					c = coin.GetOrAddCoin(c)
+				}
+			case exchange.JSON_FILE:
+				c = e.GetCoinBySymbol(symbol)
+			}
+
+			if c != nil && e.GetCoinConstraint(c) == nil {
+				coinConstraint := &exchange.CoinConstraint{
+					CoinID:       c.ID,
+					Coin:         c,
+					ExSymbol:     symbol,
+					ChainType:    exchange.MAINNET,
+					TxFee:        DEFAULT_TXFEE,
+					Withdraw:     DEFAULT_WITHDRAW,
+					Deposit:      DEFAULT_DEPOSIT,
+					Confirmation: DEFAULT_CONFIRMATION,
+					Listed:       true,
+				}
+				e.SetCoinConstraint(coinConstraint)
+			}
+		}
+	}
+	return nil
+}
+
+/* getChainType - token networks are named after the chain they run on, eg: USDT on ETH -> ERC20 */
+func getChainType(symbol, network string) exchange.ChainType {
+	if symbol == network {
+		return exchange.MAINNET
+	}
+	switch network {
+	case "ETH":
+		return exchange.ERC20
+	case "BNB":
+		return exchange.BEP2
+	case "NEO":
+		return exchange.NEP5
+	case "OMNI":
+		return exchange.OMNI
+	case "TRX":
+		return exchange.TRC20
+	}
+	return exchange.MAINNET
+}
+
 /* GetPairsData - Get Pairs Information (If API provide)
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
@@ -117,8 +216,8 @@ func (e *Binance) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(data.QuoteAsset)
-				target := coin.GetCoin(data.BaseAsset)
+				base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+				target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -127,34 +226,40 @@ func (e *Binance) GetPairsData() error {
 			}
 			if p != nil {
 				var err error
-				lotsize := 0.0
-				priceFilter := 0.0
+				lotsize := decimal.Zero
+				priceFilter := decimal.Zero
+				pairConstraint := &exchange.PairConstraint{}
 				for _, filter := range data.Filters {
 					switch filter.FilterType {
 					case "LOT_SIZE":
-						lotsize, err = strconv.ParseFloat(filter.StepSize, 64)
+						lotsize, err = decimal.NewFromString(filter.StepSize)
 						if err != nil {
 							log.Printf("%s Lot Size Err: %v", e.GetName(), err)
-							lotsize = DEFAULT_LOT_SIZE
+							lotsize = decimal.NewFromFloat(DEFAULT_LOT_SIZE)
 						}
+						pairConstraint.MinQuantity, _ = decimal.NewFromString(filter.MinQty)
+						pairConstraint.MaxQuantity, _ = decimal.NewFromString(filter.MaxQty)
+					case "MARKET_LOT_SIZE":
+						pairConstraint.MarketMinQuantity, _ = decimal.NewFromString(filter.MinQty)
+						pairConstraint.MarketMaxQuantity, _ = decimal.NewFromString(filter.MaxQty)
+					case "MIN_NOTIONAL", "NOTIONAL":
+						pairConstraint.MinNotional, _ = decimal.NewFromString(filter.MinNotional)
 					case "PRICE_FILTER":
-						priceFilter, err = strconv.ParseFloat(filter.TickSize, 64)
+						priceFilter, err = decimal.NewFromString(filter.TickSize)
 						if err != nil {
 							log.Printf("%s Price Filter Err: %v", e.GetName(), err)
-							priceFilter = DEFAULT_PRICE_FILTER
+							priceFilter = decimal.NewFromFloat(DEFAULT_PRICE_FILTER)
 						}
 					}
 				}
-				pairConstraint := &exchange.PairConstraint{
-					PairID:      p.ID,
-					Pair:        p,
-					ExSymbol:    data.Symbol,
-					MakerFee:    DEFAULT_MAKER_FEE,
-					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     lotsize,
-					PriceFilter: priceFilter,
-					Listed:      true,
-				}
+				pairConstraint.PairID = p.ID
+				pairConstraint.Pair = p
+				pairConstraint.ExSymbol = data.Symbol
+				pairConstraint.MakerFee = DEFAULT_MAKER_FEE
+				pairConstraint.TakerFee = DEFAULT_TAKER_FEE
+				pairConstraint.LotSize = lotsize
+				pairConstraint.PriceFilter = priceFilter
+				pairConstraint.Listed = true
 				e.SetPairConstraint(pairConstraint)
 			}
 		}
@@ -195,28 +300,28 @@ func (e *Binance) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1].(string), 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		buydata.Rate, err = strconv.ParseFloat(bid[0].(string), 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1].(string), 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		selldata.Rate, err = strconv.ParseFloat(ask[0].(string), 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -247,7 +352,7 @@ func (e *Binance) UpdateAllBalances() {
 			} else {
 				c := e.GetCoinBySymbol(balance.Asset)
 				if c != nil {
-					balanceMap.Set(c.Code, freeamount)
+					e.balanceMap.Set(c.Code, freeamount)
 				}
 			}
 		}
@@ -261,6 +366,11 @@ func (e *Binance) Withdraw(coin *coin.Coin, quantity float64, addr, tag string)
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	withdraw := WithdrawResponse{}
 	strRequest := "/wapi/v3/withdraw.html"
 
@@ -291,19 +401,21 @@ func (e *Binance) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v3/order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
 	mapParams["timeInForce"] = "GTC"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -315,8 +427,8 @@ func (e *Binance) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%d", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -329,19 +441,21 @@ func (e *Binance) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v3/order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
 	mapParams["timeInForce"] = "GTC"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -353,8 +467,8 @@ func (e *Binance) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%d", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -437,6 +551,307 @@ func (e *Binance) CancelAllOrder() error {
 	return nil
 }
 
+/*************** Margin API ***************/
+/* Binance has one cross margin account, the pair is only used by the margin orders */
+func (e *Binance) getMarginAccount() (*MarginAccount, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	marginAccount := &MarginAccount{}
+	strRequest := "/sapi/v1/margin/account"
+
+	jsonMarginReturn := e.ApiKeyGet(make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonMarginReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return nil, fmt.Errorf("%s Get Margin Account failed:%v Message:%v", e.GetName(), errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonMarginReturn), marginAccount); err != nil {
+		return nil, fmt.Errorf("%s Get Margin Account Unmarshal Err: %v %v", e.GetName(), err, jsonMarginReturn)
+	}
+
+	return marginAccount, nil
+}
+
+func (e *Binance) GetMarginBalances(pair *pair.Pair) ([]*exchange.MarginBalance, error) {
+	marginAccount, err := e.getMarginAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := []*exchange.MarginBalance{}
+	for _, asset := range marginAccount.UserAssets {
+		c := e.GetCoinBySymbol(asset.Asset)
+		if c == nil {
+			continue
+		}
+		balance := &exchange.MarginBalance{Coin: c}
+		balance.Free, _ = strconv.ParseFloat(asset.Free, 64)
+		balance.Locked, _ = strconv.ParseFloat(asset.Locked, 64)
+		balance.Borrowed, _ = strconv.ParseFloat(asset.Borrowed, 64)
+		balance.Interest, _ = strconv.ParseFloat(asset.Interest, 64)
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+/* GetMarginLevel - total asset / total liability of the margin account */
+func (e *Binance) GetMarginLevel(pair *pair.Pair) (float64, error) {
+	marginAccount, err := e.getMarginAccount()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(marginAccount.MarginLevel, 64)
+}
+
+func (e *Binance) GetMaxBorrowable(pair *pair.Pair, coin *coin.Coin) (float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return 0, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	maxBorrowable := MaxBorrowable{}
+	strRequest := "/sapi/v1/margin/maxBorrowable"
+
+	mapParams := make(map[string]string)
+	mapParams["asset"] = e.GetSymbolByCoin(coin)
+
+	jsonMaxReturn := e.ApiKeyGet(mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonMaxReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return 0, fmt.Errorf("%s GetMaxBorrowable failed:%v Message:%v", e.GetName(), errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonMaxReturn), &maxBorrowable); err != nil {
+		return 0, fmt.Errorf("%s GetMaxBorrowable Unmarshal Err: %v %v", e.GetName(), err, jsonMaxReturn)
+	}
+
+	return strconv.ParseFloat(maxBorrowable.Amount, 64)
+}
+
+func (e *Binance) Borrow(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	return e.marginTransaction("/sapi/v1/margin/loan", coin, quantity)
+}
+
+func (e *Binance) Repay(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	return e.marginTransaction("/sapi/v1/margin/repay", coin, quantity)
+}
+
+func (e *Binance) marginTransaction(strRequest string, coin *coin.Coin, quantity float64) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	transaction := MarginTransaction{}
+
+	mapParams := make(map[string]string)
+	mapParams["asset"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonTransactionReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransactionReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return fmt.Errorf("%s %s failed:%v Message:%v", e.GetName(), strRequest, errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonTransactionReturn), &transaction); err != nil {
+		return fmt.Errorf("%s %s Unmarshal Err: %v %v", e.GetName(), strRequest, err, jsonTransactionReturn)
+	} else if transaction.TranID == 0 {
+		return fmt.Errorf("%s %s failed: %v", e.GetName(), strRequest, jsonTransactionReturn)
+	}
+
+	return nil
+}
+
+func (e *Binance) MarginLimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "SELL", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitSell %v", e.GetName(), err)
+	}
+	order.Side = "Sell"
+	return order, nil
+}
+
+func (e *Binance) MarginLimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "BUY", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitBuy %v", e.GetName(), err)
+	}
+	order.Side = "Buy"
+	return order, nil
+}
+
+func (e *Binance) placeMarginOrder(pair *pair.Pair, side string, quantity, rate float64) (*exchange.Order, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("API Key or Secret Key are nil.")
+	}
+
+	placeOrder := PlaceOrder{}
+	strRequest := "/sapi/v1/margin/order"
+
+	mapParams := make(map[string]string)
+	mapParams["symbol"] = e.GetSymbolByPair(pair)
+	mapParams["side"] = side
+	mapParams["type"] = "LIMIT"
+	mapParams["timeInForce"] = "GTC"
+	mapParams["price"] = exchange.FormatStep(rate, e.GetPriceFilter(pair))
+	mapParams["quantity"] = exchange.FormatStep(quantity, e.GetLotSize(pair))
+
+	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
+		return nil, fmt.Errorf("Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	} else if placeOrder.Code != 0 {
+		return nil, fmt.Errorf("failed:%v Message:%v", placeOrder.Code, placeOrder.Msg)
+	}
+
+	order := &exchange.Order{
+		Pair:         pair,
+		OrderID:      fmt.Sprintf("%d", placeOrder.OrderID),
+		Rate:         decimal.NewFromFloat(rate),
+		Quantity:     decimal.NewFromFloat(quantity),
+		Status:       exchange.New,
+		JsonResponse: jsonPlaceReturn,
+	}
+	return order, nil
+}
+
+/*************** Transfer API ***************/
+func (e *Binance) GetAccountTypes() []exchange.AccountType {
+	return []exchange.AccountType{exchange.SPOT, exchange.MARGIN, exchange.FUTURES, exchange.FUNDING}
+}
+
+/* InternalTransfer - universal transfer between the wallets, eg: type MAIN_MARGIN moves from spot to margin */
+func (e *Binance) InternalTransfer(coin *coin.Coin, quantity float64, from, to exchange.AccountType) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	fromWallet, okFrom := ACCOUNT_TYPE[from]
+	toWallet, okTo := ACCOUNT_TYPE[to]
+	if !okFrom || !okTo || from == to {
+		return fmt.Errorf("%s InternalTransfer from %s to %s is not supported", e.GetName(), from, to)
+	}
+
+	errResponse := ErrorResponse{}
+	transfer := TransferResponse{}
+	strRequest := "/sapi/v1/asset/transfer"
+
+	mapParams := make(map[string]string)
+	mapParams["type"] = fmt.Sprintf("%s_%s", fromWallet, toWallet)
+	mapParams["asset"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonTransferReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransferReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return fmt.Errorf("%s InternalTransfer failed:%v Message:%v", e.GetName(), errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonTransferReturn), &transfer); err != nil {
+		return fmt.Errorf("%s InternalTransfer Unmarshal Err: %v %v", e.GetName(), err, jsonTransferReturn)
+	} else if transfer.TranID == 0 {
+		return fmt.Errorf("%s InternalTransfer failed: %v", e.GetName(), jsonTransferReturn)
+	}
+
+	return nil
+}
+
+/*************** Sub Account API ***************/
+/* Sub-accounts are managed with the master account keys, the sub-account is identified by its email */
+func (e *Binance) GetSubAccounts() ([]*exchange.SubAccount, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	subAccountList := SubAccountList{}
+	strRequest := "/sapi/v1/sub-account/list"
+
+	jsonSubAccountReturn := e.ApiKeyGet(make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonSubAccountReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return nil, fmt.Errorf("%s GetSubAccounts failed:%v Message:%v", e.GetName(), errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonSubAccountReturn), &subAccountList); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccounts Unmarshal Err: %v %v", e.GetName(), err, jsonSubAccountReturn)
+	}
+
+	subAccounts := []*exchange.SubAccount{}
+	for _, sub := range subAccountList.SubAccounts {
+		subAccounts = append(subAccounts, &exchange.SubAccount{
+			ID:     sub.Email,
+			Frozen: sub.IsFreeze,
+		})
+	}
+	return subAccounts, nil
+}
+
+func (e *Binance) GetSubAccountBalances(sub *exchange.SubAccount) (map[string]float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	subAccountAssets := SubAccountAssets{}
+	strRequest := "/sapi/v3/sub-account/assets"
+
+	mapParams := make(map[string]string)
+	mapParams["email"] = sub.ID
+
+	jsonAssetsReturn := e.ApiKeyGet(mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonAssetsReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return nil, fmt.Errorf("%s GetSubAccountBalances failed:%v Message:%v", e.GetName(), errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonAssetsReturn), &subAccountAssets); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccountBalances Unmarshal Err: %v %v", e.GetName(), err, jsonAssetsReturn)
+	}
+
+	balances := make(map[string]float64)
+	for _, balance := range subAccountAssets.Balances {
+		c := e.GetCoinBySymbol(balance.Asset)
+		if c != nil {
+			balances[c.Code] = balance.Free
+		}
+	}
+	return balances, nil
+}
+
+func (e *Binance) TransferToSubAccount(sub *exchange.SubAccount, coin *coin.Coin, quantity float64) error {
+	mapParams := make(map[string]string)
+	mapParams["toEmail"] = sub.ID
+	return e.subAccountTransfer(mapParams, coin, quantity)
+}
+
+func (e *Binance) TransferFromSubAccount(sub *exchange.SubAccount, coin *coin.Coin, quantity float64) error {
+	mapParams := make(map[string]string)
+	mapParams["fromEmail"] = sub.ID
+	return e.subAccountTransfer(mapParams, coin, quantity)
+}
+
+/* subAccountTransfer - universal transfer between the spot wallets, the master account is the side without email */
+func (e *Binance) subAccountTransfer(mapParams map[string]string, coin *coin.Coin, quantity float64) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	transfer := TransferResponse{}
+	strRequest := "/sapi/v1/sub-account/universalTransfer"
+
+	mapParams["fromAccountType"] = "SPOT"
+	mapParams["toAccountType"] = "SPOT"
+	mapParams["asset"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonTransferReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransferReturn), &errResponse); err == nil && errResponse.Code != 0 {
+		return fmt.Errorf("%s SubAccount Transfer failed:%v Message:%v", e.GetName(), errResponse.Code, errResponse.Msg)
+	}
+	if err := json.Unmarshal([]byte(jsonTransferReturn), &transfer); err != nil {
+		return fmt.Errorf("%s SubAccount Transfer Unmarshal Err: %v %v", e.GetName(), err, jsonTransferReturn)
+	} else if transfer.TranID == 0 {
+		return fmt.Errorf("%s SubAccount Transfer failed: %v", e.GetName(), jsonTransferReturn)
+	}
+
+	return nil
+}
+
 /*************** Signature Http Request ***************/
 /*Method: API Request and Signature is required
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
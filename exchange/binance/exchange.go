@@ -6,6 +6,7 @@ package binance
 
 import (
 	"fmt"
+	"io/fs"
 	"log"
 	"sort"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/utils"
+	"github.com/shopspring/decimal"
 )
 
 type Binance struct {
@@ -29,41 +31,62 @@ type Binance struct {
 
 	Source    exchange.DataSource // / exchange API / microservicve api 1 / PSQL
 	SourceURI string
+	SourceFS  fs.FS
+
+	balanceMap cmap.ConcurrentMap
 }
 
-var pairConstraintMap cmap.ConcurrentMap
-var coinConstraintMap cmap.ConcurrentMap
-var balanceMap cmap.ConcurrentMap
+var constraints exchange.ConstraintStore // the coin and pair constraints, shared by the instances
 
-var instance *Binance
 var once sync.Once
+var initErr error
 
 /***************************************************/
 func CreateBinance(config *exchange.Config) *Binance {
-	once.Do(func() {
-		instance = &Binance{
-			ID:      DEFAULT_ID,
-			Name:    "Binance",
-			Website: "https://www.binance.com/",
-
-			API_KEY:    config.API_KEY,
-			API_SECRET: config.API_SECRET,
-			Source:     config.Source,
-			SourceURI:  config.SourceURI,
-		}
+	instance := &Binance{
+		ID:      DEFAULT_ID,
+		Name:    "Binance",
+		Website: "https://www.binance.com/",
+
+		API_KEY:    config.API_KEY,
+		API_SECRET: config.API_SECRET,
+		Source:     config.Source,
+		SourceURI:  config.SourceURI,
+		SourceFS:   config.SourceFS,
+	}
 
-		balanceMap = cmap.New()
-		coinConstraintMap = cmap.New()
-		pairConstraintMap = cmap.New()
+	instance.balanceMap = cmap.New()
 
-		if err := instance.InitData(); err != nil {
-			log.Printf("%v", err)
-			instance = nil
+	once.Do(func() {
+		constraints.Store(exchange.NewConstraintMaps())
+
+		if initErr = instance.InitData(); initErr != nil {
+			log.Printf("%v", initErr)
 		}
 	})
+	if initErr != nil {
+		return nil
+	}
 	return instance
 }
 
+/* NewSubAccountInstance - an adapter with the sub-account API keys, coin and pair data are shared with the master */
+func (e *Binance) NewSubAccountInstance(config *exchange.Config) exchange.Exchange {
+	return &Binance{
+		ID:      e.ID,
+		Name:    e.Name,
+		Website: e.Website,
+
+		API_KEY:    config.API_KEY,
+		API_SECRET: config.API_SECRET,
+		Source:     e.Source,
+		SourceURI:  e.SourceURI,
+		SourceFS:   e.SourceFS,
+
+		balanceMap: cmap.New(),
+	}
+}
+
 func (e *Binance) InitData() error {
 	switch e.Source {
 	case exchange.EXCHANGE_API:
@@ -74,18 +97,13 @@ func (e *Binance) InitData() error {
 			return err
 		}
 		break
-	case exchange.MICROSERVICE_API:
-		break
-	case exchange.JSON_FILE:
-		exchangeData := utils.GetExchangeDataFromJSON(e.SourceURI, e.GetName())
-		if exchangeData == nil {
-			return fmt.Errorf("%s Initial Data Error.", e.GetName())
-		} else {
-			coinConstraintMap = exchangeData.CoinConstraint
-			pairConstraintMap = exchangeData.PairConstraint
+	case exchange.MICROSERVICE_API, exchange.JSON_FILE, exchange.PSQL:
+		exchangeData, err := utils.GetExchangeData(e.Source, e.SourceURI, e.SourceFS, e.GetName())
+		if err != nil {
+			return err
 		}
+		constraints.Store(exchangeData)
 		break
-	case exchange.PSQL:
 	default:
 		return fmt.Errorf("%s Initial Coin: There is not selected data source.", e.GetName())
 	}
@@ -106,7 +124,7 @@ func (e *Binance) GetTradingWebURL(pair *pair.Pair) string {
 }
 
 func (e *Binance) GetBalance(coin *coin.Coin) float64 {
-	if tmp, ok := balanceMap.Get(coin.Code); ok {
+	if tmp, ok := e.balanceMap.Get(coin.Code); ok {
 		return tmp.(float64)
 	} else {
 		return 0.0
@@ -115,20 +133,20 @@ func (e *Binance) GetBalance(coin *coin.Coin) float64 {
 
 /*************** Coins on the Exchanges ***************/
 func (e *Binance) GetCoinConstraint(coin *coin.Coin) *exchange.CoinConstraint {
-	if tmp, ok := coinConstraintMap.Get(fmt.Sprintf("%d", coin.ID)); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(fmt.Sprintf("%d", coin.ID)); ok {
 		return tmp.(*exchange.CoinConstraint)
 	}
 	return nil
 }
 
 func (e *Binance) SetCoinConstraint(coinConstraint *exchange.CoinConstraint) {
-	coinConstraintMap.Set(fmt.Sprintf("%d", coinConstraint.CoinID), coinConstraint)
+	constraints.Load().SetCoinConstraint(coinConstraint)
 }
 
 func (e *Binance) GetCoins() []*coin.Coin {
 	coinList := []*coin.Coin{}
 	keySort := []int{}
-	for _, key := range coinConstraintMap.Keys() {
+	for _, key := range constraints.Load().CoinConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -143,22 +161,15 @@ func (e *Binance) GetCoins() []*coin.Coin {
 }
 
 func (e *Binance) GetCoinBySymbol(symbol string) *coin.Coin {
-	for _, id := range coinConstraintMap.Keys() {
-		if tmp, ok := coinConstraintMap.Get(id); ok {
-			cc := tmp.(*exchange.CoinConstraint)
-			if cc.ExSymbol == symbol {
-				return cc.Coin
-			}
-		} else {
-			log.Printf("Get ID %s CoinConstraint Err", id)
-		}
+	if tmp, ok := constraints.Load().CoinSymbol.Get(symbol); ok {
+		return tmp.(*exchange.CoinConstraint).Coin
 	}
 	return nil
 }
 
 func (e *Binance) GetSymbolByCoin(coin *coin.Coin) string {
 	key := fmt.Sprintf("%d", coin.ID)
-	if tmp, ok := coinConstraintMap.Get(key); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(key); ok {
 		cc := tmp.(*exchange.CoinConstraint)
 		return cc.ExSymbol
 	}
@@ -166,25 +177,25 @@ func (e *Binance) GetSymbolByCoin(coin *coin.Coin) string {
 }
 
 func (e *Binance) DeleteCoin(coin *coin.Coin) {
-	coinConstraintMap.Remove(fmt.Sprintf("%d", coin.ID))
+	constraints.Load().DeleteCoinConstraint(coin.ID)
 }
 
 /*************** Pairs on the Exchanges ***************/
 func (e *Binance) GetPairConstraint(pair *pair.Pair) *exchange.PairConstraint {
-	if tmp, ok := pairConstraintMap.Get(fmt.Sprintf("%d", pair.ID)); ok {
+	if tmp, ok := constraints.Load().PairConstraint.Get(fmt.Sprintf("%d", pair.ID)); ok {
 		return tmp.(*exchange.PairConstraint)
 	}
 	return nil
 }
 
 func (e *Binance) SetPairConstraint(pairConstraint *exchange.PairConstraint) {
-	pairConstraintMap.Set(fmt.Sprintf("%d", pairConstraint.PairID), pairConstraint)
+	constraints.Load().SetPairConstraint(pairConstraint)
 }
 
 func (e *Binance) GetPairs() []*pair.Pair {
 	pairList := []*pair.Pair{}
 	keySort := []int{}
-	for _, key := range pairConstraintMap.Keys() {
+	for _, key := range constraints.Load().PairConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -199,13 +210,8 @@ func (e *Binance) GetPairs() []*pair.Pair {
 }
 
 func (e *Binance) GetPairBySymbol(symbol string) *pair.Pair {
-	for _, id := range pairConstraintMap.Keys() {
-		if tmp, ok := pairConstraintMap.Get(id); ok {
-			pc := tmp.(*exchange.PairConstraint)
-			if pc.ExSymbol == symbol {
-				return pc.Pair
-			}
-		}
+	if tmp, ok := constraints.Load().PairSymbol.Get(symbol); ok {
+		return tmp.(*exchange.PairConstraint).Pair
 	}
 	return nil
 }
@@ -219,11 +225,11 @@ func (e *Binance) GetSymbolByPair(pair *pair.Pair) string {
 }
 
 func (e *Binance) HasPair(pair *pair.Pair) bool {
-	return pairConstraintMap.Has(fmt.Sprintf("%d", pair.ID))
+	return constraints.Load().PairConstraint.Has(fmt.Sprintf("%d", pair.ID))
 }
 
 func (e *Binance) DeletePair(pair *pair.Pair) {
-	pairConstraintMap.Remove(fmt.Sprintf("%d", pair.ID))
+	constraints.Load().DeletePairConstraint(pair.ID)
 }
 
 /**************** Exchange Constraint ****************/
@@ -232,7 +238,7 @@ func (e *Binance) GetConstraintFetchMethod(pair *pair.Pair) *exchange.ConstrainF
 	constrainFetchMethod.PublicAPI = true
 	constrainFetchMethod.PrivateAPI = true
 	constrainFetchMethod.HealthAPI = true
-	constrainFetchMethod.HasWithdraw = false
+	constrainFetchMethod.HasWithdraw = true
 	constrainFetchMethod.Fee = false
 	constrainFetchMethod.LotSize = true
 	constrainFetchMethod.PriceFilter = true
@@ -290,18 +296,18 @@ func (e *Binance) GetFee(pair *pair.Pair) float64 {
 	return pairConstraint.TakerFee
 }
 
-func (e *Binance) GetLotSize(pair *pair.Pair) float64 {
+func (e *Binance) GetLotSize(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.LotSize
 }
 
-func (e *Binance) GetPriceFilter(pair *pair.Pair) float64 {
+func (e *Binance) GetPriceFilter(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	if pairConstraint == nil {
-		return 0.0
+		return decimal.Zero
 	}
 	return pairConstraint.PriceFilter
 }
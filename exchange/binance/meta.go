@@ -4,10 +4,24 @@ package binance
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
+import "github.com/bitontop/gored/exchange"
+
 const (
 	DEFAULT_ID           = 1
 	DEFAULT_TAKER_FEE    = 0.001
 	DEFAULT_MAKER_FEE    = 0.001
 	DEFAULT_LOT_SIZE     = 0.00000001
 	DEFAULT_PRICE_FILTER = 0.00000001 //PRICE FILTER
+	DEFAULT_TXFEE        = 0.0
+	DEFAULT_WITHDRAW     = true
+	DEFAULT_DEPOSIT      = true
+	DEFAULT_CONFIRMATION = 2
 )
+
+/* Binance wallet names used by the universal transfer */
+var ACCOUNT_TYPE = map[exchange.AccountType]string{
+	exchange.SPOT:    "MAIN",
+	exchange.MARGIN:  "MARGIN",
+	exchange.FUTURES: "UMFUTURE",
+	exchange.FUNDING: "FUNDING",
+}
@@ -83,53 +83,82 @@ type PairsData struct {
 	} `json:"symbols"`
 }
 
+type ErrorResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
 type CoinsData []struct {
-	ID                      string      `json:"id"`
-	AssetCode               string      `json:"assetCode"`
-	AssetName               string      `json:"assetName"`
-	Unit                    string      `json:"unit"`
-	TransactionFee          float64     `json:"transactionFee"`
-	CommissionRate          float64     `json:"commissionRate"`
-	FreeAuditWithdrawAmt    float64     `json:"freeAuditWithdrawAmt"`
-	FreeUserChargeAmount    float64     `json:"freeUserChargeAmount"`
-	MinProductWithdraw      string      `json:"minProductWithdraw"`
-	WithdrawIntegerMultiple string      `json:"withdrawIntegerMultiple"`
-	ConfirmTimes            string      `json:"confirmTimes"`
-	ChargeLockConfirmTimes  interface{} `json:"chargeLockConfirmTimes"`
-	CreateTime              interface{} `json:"createTime"`
-	Test                    int         `json:"test"`
-	URL                     string      `json:"url"`
-	AddressURL              string      `json:"addressUrl"`
-	BlockURL                string      `json:"blockUrl"`
-	EnableCharge            bool        `json:"enableCharge"`
-	EnableWithdraw          bool        `json:"enableWithdraw"`
-	RegEx                   string      `json:"regEx"`
-	RegExTag                string      `json:"regExTag"`
-	Gas                     float64     `json:"gas"`
-	ParentCode              string      `json:"parentCode"`
-	IsLegalMoney            bool        `json:"isLegalMoney"`
-	ReconciliationAmount    float64     `json:"reconciliationAmount"`
-	SeqNum                  string      `json:"seqNum"`
-	ChineseName             string      `json:"chineseName"`
-	CnLink                  string      `json:"cnLink"`
-	EnLink                  string      `json:"enLink"`
-	LogoURL                 string      `json:"logoUrl"`
-	FullLogoURL             string      `json:"fullLogoUrl"`
-	ForceStatus             bool        `json:"forceStatus"`
-	ResetAddressStatus      bool        `json:"resetAddressStatus"`
-	ChargeDescCn            interface{} `json:"chargeDescCn"`
-	ChargeDescEn            interface{} `json:"chargeDescEn"`
-	AssetLabel              interface{} `json:"assetLabel"`
-	SameAddress             bool        `json:"sameAddress"`
-	DepositTipStatus        bool        `json:"depositTipStatus"`
-	DynamicFeeStatus        bool        `json:"dynamicFeeStatus"`
-	DepositTipEn            interface{} `json:"depositTipEn"`
-	DepositTipCn            interface{} `json:"depositTipCn"`
-	AssetLabelEn            interface{} `json:"assetLabelEn"`
-	SupportMarket           interface{} `json:"supportMarket"`
-	FeeReferenceAsset       string      `json:"feeReferenceAsset"`
-	FeeRate                 float64     `json:"feeRate"`
-	FeeDigit                int         `json:"feeDigit"`
-	AssetDigit              int         `json:"assetDigit"`
-	LegalMoney              bool        `json:"legalMoney"`
+	Coin              string `json:"coin"`
+	Name              string `json:"name"`
+	DepositAllEnable  bool   `json:"depositAllEnable"`
+	WithdrawAllEnable bool   `json:"withdrawAllEnable"`
+	Trading           bool   `json:"trading"`
+	IsLegalMoney      bool   `json:"isLegalMoney"`
+	NetworkList       []struct {
+		Network                 string `json:"network"`
+		Coin                    string `json:"coin"`
+		Name                    string `json:"name"`
+		IsDefault               bool   `json:"isDefault"`
+		DepositEnable           bool   `json:"depositEnable"`
+		WithdrawEnable          bool   `json:"withdrawEnable"`
+		DepositDesc             string `json:"depositDesc"`
+		WithdrawDesc            string `json:"withdrawDesc"`
+		WithdrawFee             string `json:"withdrawFee"`
+		WithdrawMin             string `json:"withdrawMin"`
+		WithdrawMax             string `json:"withdrawMax"`
+		WithdrawIntegerMultiple string `json:"withdrawIntegerMultiple"`
+		MinConfirm              int    `json:"minConfirm"`
+		UnLockConfirm           int    `json:"unLockConfirm"`
+		AddressRegex            string `json:"addressRegex"`
+		MemoRegex               string `json:"memoRegex"`
+		SpecialTips             string `json:"specialTips"`
+		ContractAddress         string `json:"contractAddress"`
+	} `json:"networkList"`
+}
+
+type MarginAccount struct {
+	BorrowEnabled       bool   `json:"borrowEnabled"`
+	MarginLevel         string `json:"marginLevel"`
+	TotalAssetOfBtc     string `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc string `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  string `json:"totalNetAssetOfBtc"`
+	TradeEnabled        bool   `json:"tradeEnabled"`
+	TransferEnabled     bool   `json:"transferEnabled"`
+	UserAssets          []struct {
+		Asset    string `json:"asset"`
+		Borrowed string `json:"borrowed"`
+		Free     string `json:"free"`
+		Interest string `json:"interest"`
+		Locked   string `json:"locked"`
+		NetAsset string `json:"netAsset"`
+	} `json:"userAssets"`
+}
+
+type MaxBorrowable struct {
+	Amount string `json:"amount"`
+}
+
+type MarginTransaction struct {
+	TranID int64 `json:"tranId"`
+}
+
+type TransferResponse struct {
+	TranID int64 `json:"tranId"`
+}
+
+type SubAccountList struct {
+	SubAccounts []struct {
+		Email      string `json:"email"`
+		IsFreeze   bool   `json:"isFreeze"`
+		CreateTime int64  `json:"createTime"`
+	} `json:"subAccounts"`
+}
+
+type SubAccountAssets struct {
+	Balances []struct {
+		Asset  string  `json:"asset"`
+		Free   float64 `json:"free"`
+		Locked float64 `json:"locked"`
+	} `json:"balances"`
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -67,13 +68,13 @@ func (e *BinanceDex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.OriginalSymbol)
+			c = exchange.GetCoin(e.GetName(), data.OriginalSymbol)
 			if c == nil {
 				c = &coin.Coin{
-					Code: data.OriginalSymbol,
+					Code: exchange.GetCoinCode(e.GetName(), data.OriginalSymbol),
 					Name: data.Name,
 				}
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Symbol)
@@ -124,8 +125,8 @@ func (e *BinanceDex) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(data.QuoteAsset)
-				target := coin.GetCoin(data.BaseAsset)
+				base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+				target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -139,8 +140,8 @@ func (e *BinanceDex) GetPairsData() error {
 					ExSymbol:    data.Symbol,
 					MakerFee:    data.MakerFee,
 					TakerFee:    data.TakerFee,
-					LotSize:     data.LotSize,
-					PriceFilter: data.PriceFilter,
+					LotSize:     decimal.NewFromFloat(data.LotSize),
+					PriceFilter: decimal.NewFromFloat(data.PriceFilter),
 					Listed:      true,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -188,15 +189,15 @@ func (e *BinanceDex) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity = bid[1]
-		buydata.Rate = bid[0]
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
+		buydata.Rate = decimal.NewFromFloat(bid[0])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity = ask[1]
-		selldata.Rate = ask[0]
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
+		selldata.Rate = decimal.NewFromFloat(ask[0])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -231,7 +232,7 @@ func (e *BinanceDex) UpdateAllBalances() {
 	for _, balance := range accountBalance {
 		c := e.GetCoinBySymbol(balance.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Available)
+			e.balanceMap.Set(c.Code, balance.Available)
 		}
 	}
 }
@@ -243,6 +244,11 @@ func (e *BinanceDex) Withdraw(coin *coin.Coin, quantity float64, addr, tag strin
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/API Path"
@@ -274,6 +280,11 @@ func (e *BinanceDex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchan
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -282,8 +293,8 @@ func (e *BinanceDex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchan
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -298,8 +309,8 @@ func (e *BinanceDex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchan
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -312,6 +323,11 @@ func (e *BinanceDex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchang
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -320,8 +336,8 @@ func (e *BinanceDex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchang
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -336,8 +352,8 @@ func (e *BinanceDex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchang
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
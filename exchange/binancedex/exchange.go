@@ -7,6 +7,7 @@ package binancedex
 import (
 	"encoding/hex"
 	"fmt"
+	"io/fs"
 	"log"
 	"sort"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/utils"
 
+	"github.com/shopspring/decimal"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
@@ -32,37 +34,41 @@ type BinanceDex struct {
 
 	Source    exchange.DataSource // / exchange API / microservicve api 1 / PSQL
 	SourceURI string
+	SourceFS  fs.FS
+
+	balanceMap cmap.ConcurrentMap
 }
 
-var pairConstraintMap cmap.ConcurrentMap
-var coinConstraintMap cmap.ConcurrentMap
-var balanceMap cmap.ConcurrentMap
+var constraints exchange.ConstraintStore // the coin and pair constraints, shared by the instances
 
-var instance *BinanceDex
 var once sync.Once
+var initErr error
 
 /***************************************************/
 func CreateBinanceDex(config *exchange.Config) *BinanceDex {
-	once.Do(func() {
-		instance = &BinanceDex{
-			ID:      DEFAULT_ID,
-			Name:    "BinanceDex",
-			Website: "https://www.binancedex.com/",
+	instance := &BinanceDex{
+		ID:      DEFAULT_ID,
+		Name:    "BinanceDex",
+		Website: "https://www.binancedex.com/",
+
+		Source:    config.Source,
+		SourceURI: config.SourceURI,
+		SourceFS:  config.SourceFS,
+	}
 
-			Source:    config.Source,
-			SourceURI: config.SourceURI,
-		}
+	instance.balanceMap = cmap.New()
+	instance.recoveryFromPrivateKey(config.API_SECRET)
 
-		balanceMap = cmap.New()
-		coinConstraintMap = cmap.New()
-		pairConstraintMap = cmap.New()
+	once.Do(func() {
+		constraints.Store(exchange.NewConstraintMaps())
 
-		instance.recoveryFromPrivateKey(config.API_SECRET)
-		if err := instance.InitData(); err != nil {
-			log.Printf("%v", err)
-			instance = nil
+		if initErr = instance.InitData(); initErr != nil {
+			log.Printf("%v", initErr)
 		}
 	})
+	if initErr != nil {
+		return nil
+	}
 	return instance
 }
 
@@ -93,18 +99,13 @@ func (e *BinanceDex) InitData() error {
 			return err
 		}
 		break
-	case exchange.MICROSERVICE_API:
-		break
-	case exchange.JSON_FILE:
-		exchangeData := utils.GetExchangeDataFromJSON(e.SourceURI, e.GetName())
-		if exchangeData == nil {
-			return fmt.Errorf("%s Initial Data Error.", e.GetName())
-		} else {
-			coinConstraintMap = exchangeData.CoinConstraint
-			pairConstraintMap = exchangeData.PairConstraint
+	case exchange.MICROSERVICE_API, exchange.JSON_FILE, exchange.PSQL:
+		exchangeData, err := utils.GetExchangeData(e.Source, e.SourceURI, e.SourceFS, e.GetName())
+		if err != nil {
+			return err
 		}
+		constraints.Store(exchangeData)
 		break
-	case exchange.PSQL:
 	default:
 		return fmt.Errorf("%s Initial Coin: There is not selected data source.", e.GetName())
 	}
@@ -125,7 +126,7 @@ func (e *BinanceDex) GetTradingWebURL(pair *pair.Pair) string {
 }
 
 func (e *BinanceDex) GetBalance(coin *coin.Coin) float64 {
-	if tmp, ok := balanceMap.Get(coin.Code); ok {
+	if tmp, ok := e.balanceMap.Get(coin.Code); ok {
 		return tmp.(float64)
 	} else {
 		return 0.0
@@ -134,20 +135,20 @@ func (e *BinanceDex) GetBalance(coin *coin.Coin) float64 {
 
 /*************** Coins on the Exchanges ***************/
 func (e *BinanceDex) GetCoinConstraint(coin *coin.Coin) *exchange.CoinConstraint {
-	if tmp, ok := coinConstraintMap.Get(fmt.Sprintf("%d", coin.ID)); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(fmt.Sprintf("%d", coin.ID)); ok {
 		return tmp.(*exchange.CoinConstraint)
 	}
 	return nil
 }
 
 func (e *BinanceDex) SetCoinConstraint(coinConstraint *exchange.CoinConstraint) {
-	coinConstraintMap.Set(fmt.Sprintf("%d", coinConstraint.CoinID), coinConstraint)
+	constraints.Load().SetCoinConstraint(coinConstraint)
 }
 
 func (e *BinanceDex) GetCoins() []*coin.Coin {
 	coinList := []*coin.Coin{}
 	keySort := []int{}
-	for _, key := range coinConstraintMap.Keys() {
+	for _, key := range constraints.Load().CoinConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -162,22 +163,15 @@ func (e *BinanceDex) GetCoins() []*coin.Coin {
 }
 
 func (e *BinanceDex) GetCoinBySymbol(symbol string) *coin.Coin {
-	for _, id := range coinConstraintMap.Keys() {
-		if tmp, ok := coinConstraintMap.Get(id); ok {
-			cc := tmp.(*exchange.CoinConstraint)
-			if cc.ExSymbol == symbol {
-				return cc.Coin
-			}
-		} else {
-			log.Printf("Get ID %s CoinConstraint Err", id)
-		}
+	if tmp, ok := constraints.Load().CoinSymbol.Get(symbol); ok {
+		return tmp.(*exchange.CoinConstraint).Coin
 	}
 	return nil
 }
 
 func (e *BinanceDex) GetSymbolByCoin(coin *coin.Coin) string {
 	key := fmt.Sprintf("%d", coin.ID)
-	if tmp, ok := coinConstraintMap.Get(key); ok {
+	if tmp, ok := constraints.Load().CoinConstraint.Get(key); ok {
 		cc := tmp.(*exchange.CoinConstraint)
 		return cc.ExSymbol
 	}
@@ -185,25 +179,25 @@ func (e *BinanceDex) GetSymbolByCoin(coin *coin.Coin) string {
 }
 
 func (e *BinanceDex) DeleteCoin(coin *coin.Coin) {
-	coinConstraintMap.Remove(fmt.Sprintf("%d", coin.ID))
+	constraints.Load().DeleteCoinConstraint(coin.ID)
 }
 
 /*************** Pairs on the Exchanges ***************/
 func (e *BinanceDex) GetPairConstraint(pair *pair.Pair) *exchange.PairConstraint {
-	if tmp, ok := pairConstraintMap.Get(fmt.Sprintf("%d", pair.ID)); ok {
+	if tmp, ok := constraints.Load().PairConstraint.Get(fmt.Sprintf("%d", pair.ID)); ok {
 		return tmp.(*exchange.PairConstraint)
 	}
 	return nil
 }
 
 func (e *BinanceDex) SetPairConstraint(pairConstraint *exchange.PairConstraint) {
-	pairConstraintMap.Set(fmt.Sprintf("%d", pairConstraint.PairID), pairConstraint)
+	constraints.Load().SetPairConstraint(pairConstraint)
 }
 
 func (e *BinanceDex) GetPairs() []*pair.Pair {
 	pairList := []*pair.Pair{}
 	keySort := []int{}
-	for _, key := range pairConstraintMap.Keys() {
+	for _, key := range constraints.Load().PairConstraint.Keys() {
 		id, _ := strconv.Atoi(key)
 		keySort = append(keySort, id)
 	}
@@ -218,13 +212,8 @@ func (e *BinanceDex) GetPairs() []*pair.Pair {
 }
 
 func (e *BinanceDex) GetPairBySymbol(symbol string) *pair.Pair {
-	for _, id := range pairConstraintMap.Keys() {
-		if tmp, ok := pairConstraintMap.Get(id); ok {
-			pc := tmp.(*exchange.PairConstraint)
-			if pc.ExSymbol == symbol {
-				return pc.Pair
-			}
-		}
+	if tmp, ok := constraints.Load().PairSymbol.Get(symbol); ok {
+		return tmp.(*exchange.PairConstraint).Pair
 	}
 	return nil
 }
@@ -238,11 +227,11 @@ func (e *BinanceDex) GetSymbolByPair(pair *pair.Pair) string {
 }
 
 func (e *BinanceDex) HasPair(pair *pair.Pair) bool {
-	return pairConstraintMap.Has(fmt.Sprintf("%d", pair.ID))
+	return constraints.Load().PairConstraint.Has(fmt.Sprintf("%d", pair.ID))
 }
 
 func (e *BinanceDex) DeletePair(pair *pair.Pair) {
-	pairConstraintMap.Remove(fmt.Sprintf("%d", pair.ID))
+	constraints.Load().DeletePairConstraint(pair.ID)
 }
 
 /**************** Exchange Constraint ****************/
@@ -294,12 +283,12 @@ func (e *BinanceDex) GetFee(pair *pair.Pair) float64 {
 	return pairConstraint.TakerFee
 }
 
-func (e *BinanceDex) GetLotSize(pair *pair.Pair) float64 {
+func (e *BinanceDex) GetLotSize(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	return pairConstraint.LotSize
 }
 
-func (e *BinanceDex) GetPriceFilter(pair *pair.Pair) float64 {
+func (e *BinanceDex) GetPriceFilter(pair *pair.Pair) decimal.Decimal {
 	pairConstraint := e.GetPairConstraint(pair)
 	return pairConstraint.PriceFilter
 }
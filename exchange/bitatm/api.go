@@ -18,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -70,12 +71,12 @@ func (e *BitATM) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Currencyname)
+			c = exchange.GetCoin(e.GetName(), data.Currencyname)
 			if c == nil {
 				c = &coin.Coin{
-					Code: data.Currencyname,
+					Code: exchange.GetCoinCode(e.GetName(), data.Currencyname),
 				}
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Currencyname)
@@ -125,8 +126,8 @@ func (e *BitATM) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.Basecurrency)
-			target := coin.GetCoin(data.Quotecurrency)
+			base := exchange.GetCoin(e.GetName(), data.Basecurrency)
+			target := exchange.GetCoin(e.GetName(), data.Quotecurrency)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -140,8 +141,8 @@ func (e *BitATM) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -184,16 +185,16 @@ func (e *BitATM) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate = bid.Price
-		buydata.Quantity = bid.Amount
+		buydata.Rate = decimal.NewFromFloat(bid.Price)
+		buydata.Quantity = decimal.NewFromFloat(bid.Amount)
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Asks {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate = ask.Price
-		selldata.Quantity = ask.Amount
+		selldata.Rate = decimal.NewFromFloat(ask.Price)
+		selldata.Quantity = decimal.NewFromFloat(ask.Amount)
 		maker.Asks = append(maker.Asks, selldata)
 	}
 	return maker, nil
@@ -227,7 +228,7 @@ func (e *BitATM) UpdateAllBalances() {
 	for _, v := range accountBalance {
 		c := e.GetCoinBySymbol(v.Currency)
 		if c != nil {
-			balanceMap.Set(c.Code, v.Balance)
+			e.balanceMap.Set(c.Code, v.Balance)
 		}
 	}
 }
@@ -238,6 +239,11 @@ func (e *BitATM) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	mapParams := make(map[string]interface{})
 	mapParams["currency"] = e.GetSymbolByCoin(coin)
 	mapParams["Amount"] = quantity
@@ -268,10 +274,15 @@ func (e *BitATM) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	mapParams := make(map[string]interface{})
 	mapParams["Symbol"] = e.GetSymbolByPair(pair)
-	mapParams["Price"] = rate
-	mapParams["Amount"] = quantity
+	mapParams["Price"] = json.Number(orderRate.String())
+	mapParams["Amount"] = json.Number(orderQuantity.String())
 	mapParams["OrderType"] = "Limit"
 	mapParams["Direction"] = "Sell"
 
@@ -292,8 +303,8 @@ func (e *BitATM) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%d", orderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -307,10 +318,15 @@ func (e *BitATM) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	mapParams := make(map[string]interface{})
 	mapParams["Symbol"] = e.GetSymbolByPair(pair)
-	mapParams["Price"] = rate
-	mapParams["Amount"] = quantity
+	mapParams["Price"] = json.Number(orderRate.String())
+	mapParams["Amount"] = json.Number(orderQuantity.String())
 	mapParams["OrderType"] = "Limit"
 	mapParams["Direction"] = "Buy"
 
@@ -331,8 +347,8 @@ func (e *BitATM) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%d", orderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -12,19 +12,22 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 
 	"strconv"
 )
 
 const (
-	API_URL string = "https://api.bitbay.net/rest"
+	API_URL         string = "https://api.bitbay.net/rest"
+	TRADING_API_URL string = "https://bitbay.net/API/Trading/tradingApi.php" // the legacy trading API, the REST API has no crypto withdraw
 )
 
 /*API Base Knowledge
@@ -70,17 +73,17 @@ func (e *Bitbay) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.Market.Second.Currency)
+			base = exchange.GetCoin(e.GetName(), data.Market.Second.Currency)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.Market.Second.Currency
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.Market.Second.Currency)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.Market.First.Currency)
+			target = exchange.GetCoin(e.GetName(), data.Market.First.Currency)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.Market.First.Currency
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.Market.First.Currency)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.Market.Second.Currency)
@@ -141,8 +144,8 @@ func (e *Bitbay) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.Market.Second.Currency)
-			target := coin.GetCoin(data.Market.First.Currency)
+			base := exchange.GetCoin(e.GetName(), data.Market.Second.Currency)
+			target := exchange.GetCoin(e.GetName(), data.Market.First.Currency)
 			if base != nil && target != nil {
 
 				p = pair.GetPair(base, target)
@@ -158,8 +161,8 @@ func (e *Bitbay) GetPairsData() error {
 				ExSymbol:    data.Market.Code,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.Market.First.Scale),
-				PriceFilter: math.Pow10(-1 * data.Market.Second.Scale),
+				LotSize:     decimal.New(1, int32(-1*data.Market.First.Scale)),
+				PriceFilter: decimal.New(1, int32(-1*data.Market.Second.Scale)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -197,27 +200,27 @@ func (e *Bitbay) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Buy {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Ca, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Ca)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		buydata.Rate, err = strconv.ParseFloat(bid.Ra, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Ra)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Sell {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Ca, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Ca)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		selldata.Rate, err = strconv.ParseFloat(ask.Ra, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Ra)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -246,14 +249,45 @@ func (e *Bitbay) UpdateAllBalances() {
 	for _, v := range accountBalance.Balances {
 		c := e.GetCoinBySymbol(v.Currency)
 		if c != nil {
-			balanceMap.Set(c.Code, v.AvailableFunds)
+			e.balanceMap.Set(c.Code, v.AvailableFunds)
 		}
 	}
 }
 
+// Withdraw - the transfer method of the trading API, its withdraw method is the fiat withdraw to a bank account.
+// transfer takes no memo, a withdraw with a tag is refused instead of sent without it
 func (e *Bitbay) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
+	if tag != "" {
+		log.Printf("%s Withdraw Failed: the trading API has no memo for %s", e.GetName(), coin.Code)
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
 
-	return false
+	transfer := TransferResponse{}
+
+	mapParams := make(map[string]string)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["address"] = addr
+
+	jsonSubmitWithdraw := e.TradingApiRequest("transfer", mapParams)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &transfer); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if transfer.Success != 1 {
+		log.Printf("%s Withdraw Failed: %v %v", e.GetName(), transfer.Code, transfer.Message)
+		return false
+	}
+
+	return true
 }
 
 func (e *Bitbay) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -261,12 +295,17 @@ func (e *Bitbay) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := fmt.Sprintf("/trading/offer/", e.GetSymbolByPair(pair))
 
 	mapParams := make(map[string]interface{})
-	price := float64(int(rate/e.GetPriceFilter(pair)+e.GetPriceFilter(pair)/10)) * (e.GetPriceFilter(pair))
-	amount := float64(int(quantity/e.GetLotSize(pair)+e.GetLotSize(pair)/10)) * (e.GetLotSize(pair))
+	price := json.Number(orderRate.String())
+	amount := json.Number(orderQuantity.String())
 	mapParams["rate"] = price
 	mapParams["amount"] = amount
 	mapParams["offerType"] = "sell"
@@ -282,8 +321,8 @@ func (e *Bitbay) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OfferID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -297,12 +336,17 @@ func (e *Bitbay) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := fmt.Sprintf("/trading/offer/", e.GetSymbolByPair(pair))
 
 	mapParams := make(map[string]interface{})
-	price := float64(int(rate/e.GetPriceFilter(pair)+e.GetPriceFilter(pair)/10)) * (e.GetPriceFilter(pair))
-	amount := float64(int(quantity/e.GetLotSize(pair)+e.GetLotSize(pair)/10)) * (e.GetLotSize(pair))
+	price := json.Number(orderRate.String())
+	amount := json.Number(orderQuantity.String())
 	mapParams["rate"] = price
 	mapParams["amount"] = amount
 	mapParams["offerType"] = "buy"
@@ -318,8 +362,8 @@ func (e *Bitbay) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OfferID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -449,3 +493,41 @@ func (e *Bitbay) ApiKeyGET(strRequestPath string, mapParams map[string]interface
 
 	return string(body)
 }
+
+// TradingApiRequest - POST to the legacy trading API, API-Hash is the HMAC-SHA512 of the form body
+func (e *Bitbay) TradingApiRequest(strMethod string, mapParams map[string]string) string {
+	values := url.Values{}
+	for key, value := range mapParams {
+		values.Set(key, value)
+	}
+	values.Set("method", strMethod)
+	values.Set("moment", fmt.Sprintf("%d", time.Now().Unix()))
+	strParams := values.Encode()
+
+	h := hmac.New(sha512.New, []byte(e.API_SECRET))
+	h.Write([]byte(strParams))
+	signature := hex.EncodeToString(h.Sum(nil))
+
+	request, err := http.NewRequest("POST", TRADING_API_URL, strings.NewReader(strParams))
+	if nil != err {
+		return err.Error()
+	}
+
+	request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Add("API-Key", e.API_KEY)
+	request.Header.Add("API-Hash", signature)
+
+	httpClient := &http.Client{}
+	response, err := httpClient.Do(request)
+	if nil != err {
+		return err.Error()
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if nil != err {
+		return err.Error()
+	}
+
+	return string(body)
+}
@@ -108,3 +108,9 @@ type CancelOrder struct {
 	Status string        `json:"status"`
 	Errors []interface{} `json:"errors"`
 }
+
+type TransferResponse struct {
+	Success int    `json:"success"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
@@ -5,6 +5,7 @@ package bitfinex
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
+	"bytes"
 	"crypto/hmac"
 	"crypto/sha512"
 	"encoding/base64"
@@ -13,6 +14,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"regexp"
 	"strconv"
@@ -22,12 +24,11 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
-/*The Base Endpoint URL*/
-const (
-	API_URL = "https://api.bitfinex.com"
-)
+/*The Base Endpoint URL, a var so the tests can point it to a local server*/
+var API_URL = "https://api.bitfinex.com"
 
 /*API Base Knowledge
 Path: API function. Usually after the base endpoint URL
@@ -74,11 +75,11 @@ func (e *Bitfinex) GetCoinsData() error {
 				c := &coin.Coin{}
 				switch e.Source {
 				case exchange.EXCHANGE_API:
-					c = coin.GetCoin(fixSymbol[1])
+					c = exchange.GetCoin(e.GetName(), fixSymbol[1])
 					if c == nil {
 						c = &coin.Coin{}
-						c.Code = fixSymbol[1]
-						coin.AddCoin(c)
+						c.Code = exchange.GetCoinCode(e.GetName(), fixSymbol[1])
+						c = coin.GetOrAddCoin(c)
 					}
 				case exchange.JSON_FILE:
 					c = e.GetCoinBySymbol(fixSymbol[1])
@@ -105,12 +106,12 @@ func (e *Bitfinex) GetCoinsData() error {
 				switch e.Source {
 				case exchange.EXCHANGE_API:
 					if c == nil {
-						c = coin.GetCoin(symbol[0])
+						c = exchange.GetCoin(e.GetName(), symbol[0])
 						if c == nil {
 							c = &coin.Coin{}
-							c.Code = symbol[0]
+							c.Code = exchange.GetCoinCode(e.GetName(), symbol[0])
 							c.Name = symbol[1]
-							coin.AddCoin(c)
+							c = coin.GetOrAddCoin(c)
 						}
 					}
 				case exchange.JSON_FILE:
@@ -188,8 +189,8 @@ func (e *Bitfinex) GetPairsData() error {
 				targetSymbol := reg.ReplaceAllString(data.Pair, "$1")
 				switch e.Source {
 				case exchange.EXCHANGE_API:
-					base := coin.GetCoin(baseSymbol)
-					target := coin.GetCoin(targetSymbol)
+					base := exchange.GetCoin(e.GetName(), baseSymbol)
+					target := exchange.GetCoin(e.GetName(), targetSymbol)
 					if base != nil && target != nil {
 						p = pair.GetPair(base, target)
 					}
@@ -204,11 +205,13 @@ func (e *Bitfinex) GetPairsData() error {
 						ExSymbol: data.Pair,
 						MakerFee: DEFAULT_MAKER_FEE,
 						TakerFee: DEFAULT_TAKER_FEE,
-						LotSize:  DEFAULT_LOT_SIZE,
+						LotSize:  decimal.NewFromFloat(DEFAULT_LOT_SIZE),
 						// api gives wrong precision value
-						PriceFilter: DEFAULT_PRICE_FILTER, //math.Pow10(data.PricePrecision * -1),
+						PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER), //math.Pow10(data.PricePrecision * -1),
 						Listed:      true,
 					}
+					pairConstraint.MinQuantity, _ = decimal.NewFromString(data.MinimumOrderSize)
+					pairConstraint.MaxQuantity, _ = decimal.NewFromString(data.MaximumOrderSize)
 					e.SetPairConstraint(pairConstraint)
 				}
 				break
@@ -245,28 +248,28 @@ func (e *Bitfinex) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Amount, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Amount)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Amount, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Amount)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -282,22 +285,24 @@ func (e *Bitfinex) UpdateAllBalances() {
 	}
 
 	accountBalance := AccountBalances{}
-	strRequest := "/v1/balances"
+	strRequest := "/v2/auth/r/wallets"
 
-	jsonBalanceReturn := e.ApiKeyPost(make(map[string]interface{}), strRequest)
+	jsonBalanceReturn := e.ApiKeyPostV2(make(map[string]interface{}), strRequest)
+	if err := e.checkError(jsonBalanceReturn); err != nil {
+		log.Printf("%s UpdateAllBalances Failed: %v", e.GetName(), err)
+		return
+	}
 	if err := json.Unmarshal([]byte(jsonBalanceReturn), &accountBalance); err != nil {
 		log.Printf("%s UpdateAllBalances json Unmarshal error: %v %s", e.GetName(), err, jsonBalanceReturn)
 		return
-	} else {
-		for _, balance := range accountBalance {
-			freeamount, err := strconv.ParseFloat(balance.Available, 64)
-			if err != nil {
-				log.Printf("%s UpdateAllBalances err: %+v %v", e.GetName(), balance, err)
-			} else {
-				c := e.GetCoinBySymbol(balance.Currency)
-				if c != nil {
-					balanceMap.Set(c.Code, freeamount)
-				}
+	}
+
+	for _, balance := range accountBalance {
+		c := e.GetCoinBySymbol(strings.ToLower(balance.Currency))
+		if c != nil {
+			e.walletBalanceMap.Set(balance.Type+":"+c.Code, balance.Available)
+			if balance.Type == WALLET_EXCHANGE {
+				e.balanceMap.Set(c.Code, balance.Available)
 			}
 		}
 	}
@@ -305,7 +310,75 @@ func (e *Bitfinex) UpdateAllBalances() {
 
 /* Withdraw(coin *coin.Coin, quantity float64, addr, tag string) */
 func (e *Bitfinex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-	return false
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	method, err := e.getWithdrawMethod(e.GetSymbolByCoin(coin))
+	if err != nil {
+		log.Printf("%s Withdraw Err: %v", e.GetName(), err)
+		return false
+	}
+
+	withdraw := WithdrawResponse{}
+	strRequest := "/v1/withdraw"
+
+	mapParams := make(map[string]interface{})
+	mapParams["withdraw_type"] = method
+	mapParams["walletselected"] = "exchange"
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["address"] = addr
+	if tag != "" {
+		mapParams["payment_id"] = tag
+	}
+
+	jsonSubmitWithdraw := e.ApiKeyPost(mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if len(withdraw) == 0 || withdraw[0].Status != "success" {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonSubmitWithdraw)
+		return false
+	}
+
+	return true
+}
+
+/* getWithdrawMethod - v1 withdraw_type is the lowercase transfer method name, eg: BTC -> bitcoin */
+func (e *Bitfinex) getWithdrawMethod(symbol string) (string, error) {
+	withdrawMethods := WithdrawMethods{}
+	strRequestUrl := "/v2/conf/pub:map:tx:method"
+	strURL := API_URL + strRequestUrl
+
+	jsonMethodReturn := exchange.HttpGetRequest(strURL, nil)
+	if err := json.Unmarshal([]byte(jsonMethodReturn), &withdrawMethods); err != nil {
+		return "", fmt.Errorf("Get Withdraw Methods Json Unmarshal Err: %v %v", err, jsonMethodReturn)
+	} else if len(withdrawMethods) == 0 {
+		return "", fmt.Errorf("Get Withdraw Methods Failed: %v", jsonMethodReturn)
+	}
+
+	for _, data := range withdrawMethods[0] {
+		if len(data) != 2 {
+			continue
+		}
+		method := ""
+		currencies := []string{}
+		if json.Unmarshal(data[0], &method) != nil || json.Unmarshal(data[1], &currencies) != nil {
+			continue
+		}
+		for _, currency := range currencies {
+			if strings.EqualFold(currency, symbol) {
+				return strings.ToLower(method), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("No withdraw method for %s", symbol)
 }
 
 func (e *Bitfinex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -313,26 +386,21 @@ func (e *Bitfinex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
-	placeOrder := PlaceOrder{}
-	strRequest := "/v1/order/new"
-
-	mapParams := make(map[string]interface{})
-	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["side"] = "sell"
-	mapParams["type"] = "exchange limit"
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 
-	jsonPlaceReturn := e.ApiKeyPost(mapParams, strRequest)
-	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Unmarshal Err: %v %v", e.GetName(), err, jsonPlaceReturn)
+	placeOrder, jsonPlaceReturn, err := e.submitOrder(pair, orderQuantity.Neg(), orderRate)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitSell %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      fmt.Sprintf("%d", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      fmt.Sprintf("%d", placeOrder.ID),
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -345,26 +413,21 @@ func (e *Bitfinex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
-	placeOrder := PlaceOrder{}
-	strRequest := "/v1/order/new"
-
-	mapParams := make(map[string]interface{})
-	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["side"] = "buy"
-	mapParams["type"] = "exchange limit"
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 
-	jsonPlaceReturn := e.ApiKeyPost(mapParams, strRequest)
-	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Unmarshal Err: %v %v", e.GetName(), err, jsonPlaceReturn)
+	placeOrder, jsonPlaceReturn, err := e.submitOrder(pair, orderQuantity, orderRate)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitBuy %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      fmt.Sprintf("%d", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      fmt.Sprintf("%d", placeOrder.ID),
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -373,48 +436,126 @@ func (e *Bitfinex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 	return order, nil
 }
 
+/* submitOrder - v2 uses a signed amount, positive to buy and negative to sell */
+func (e *Bitfinex) submitOrder(pair *pair.Pair, amount, rate decimal.Decimal) (*OrderData, string, error) {
+	notification := Notification{}
+	placeOrder := []OrderData{}
+	strRequest := "/v2/auth/w/order/submit"
+
+	mapParams := make(map[string]interface{})
+	mapParams["type"] = "EXCHANGE LIMIT"
+	mapParams["symbol"] = e.getTradingSymbol(pair)
+	mapParams["amount"] = amount.String()
+	mapParams["price"] = rate.String()
+
+	jsonPlaceReturn := e.ApiKeyPostV2(mapParams, strRequest)
+	if err := e.checkError(jsonPlaceReturn); err != nil {
+		return nil, jsonPlaceReturn, err
+	}
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &notification); err != nil {
+		return nil, jsonPlaceReturn, fmt.Errorf("Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	} else if notification.Status != "SUCCESS" {
+		return nil, jsonPlaceReturn, fmt.Errorf("Failed: %v %v", notification.Status, notification.Text)
+	}
+	if err := json.Unmarshal(notification.Data, &placeOrder); err != nil {
+		return nil, jsonPlaceReturn, fmt.Errorf("Order Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	} else if len(placeOrder) == 0 {
+		return nil, jsonPlaceReturn, fmt.Errorf("Failed: %v", jsonPlaceReturn)
+	}
+
+	return &placeOrder[0], jsonPlaceReturn, nil
+}
+
+/* OrderStatus - the order is looked up in the active orders first, then in the order history */
 func (e *Bitfinex) OrderStatus(order *exchange.Order) error {
 	if e.API_KEY == "" || e.API_SECRET == "" {
 		return fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
-	orderStatus := PlaceOrder{}
-	strRequest := "/v1/order/status"
+	orderID, err := strconv.ParseInt(order.OrderID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s OrderStatus Invalid OrderID: %v", e.GetName(), order.OrderID)
+	}
 
 	mapParams := make(map[string]interface{})
-	mapParams["order_id"], _ = strconv.Atoi(order.OrderID)
+	mapParams["id"] = []int64{orderID}
+
+	for _, strRequest := range []string{"/v2/auth/r/orders", "/v2/auth/r/orders/hist"} {
+		orderStatus := OpenOrders{}
+		jsonOrderStatus := e.ApiKeyPostV2(mapParams, strRequest)
+		if err := e.checkError(jsonOrderStatus); err != nil {
+			return fmt.Errorf("%s OrderStatus Failed: %v", e.GetName(), err)
+		}
+		if err := json.Unmarshal([]byte(jsonOrderStatus), &orderStatus); err != nil {
+			return fmt.Errorf("%s OrderStatus Unmarshal Err: %v %v", e.GetName(), err, jsonOrderStatus)
+		}
 
-	jsonOrderStatus := e.ApiKeyPost(mapParams, strRequest)
-	if err := json.Unmarshal([]byte(jsonOrderStatus), &orderStatus); err != nil {
-		return fmt.Errorf("%s OrderStatus Unmarshal Err: %v %v", e.GetName(), err, jsonOrderStatus)
-	} else if orderStatus.ID == 0 {
-		return fmt.Errorf("%s Get OrderStatus Failed: %s", e.GetName(), jsonOrderStatus)
+		for _, data := range orderStatus {
+			if data.ID == orderID {
+				order.StatusMessage = jsonOrderStatus
+				setOrderStatus(order, &data)
+				return nil
+			}
+		}
 	}
 
-	if orderStatus.IsLive {
-		remain, _ := strconv.ParseFloat(orderStatus.RemainingAmount, 64)
+	return fmt.Errorf("%s Get OrderStatus Failed: order %v not found", e.GetName(), order.OrderID)
+}
+
+func setOrderStatus(order *exchange.Order, data *OrderData) {
+	dealQuantity := math.Abs(data.AmountOrig) - math.Abs(data.Amount)
 
-		if remain == 0 {
-			order.Status = exchange.Filled
-		} else if remain > 0 && remain != order.Quantity {
-			order.Status = exchange.Partial
-		} else {
-			order.Status = exchange.New
-		}
-	} else if orderStatus.IsCancelled {
+	if strings.HasPrefix(data.Status, "ACTIVE") {
+		order.Status = exchange.New
+	} else if strings.HasPrefix(data.Status, "PARTIALLY FILLED") {
+		order.Status = exchange.Partial
+	} else if strings.HasPrefix(data.Status, "EXECUTED") {
+		order.Status = exchange.Filled
+	} else if strings.HasPrefix(data.Status, "CANCELED") {
 		order.Status = exchange.Canceled
 	} else {
 		order.Status = exchange.Other
 	}
 
-	order.DealRate = order.Rate
-	order.DealQuantity, _ = strconv.ParseFloat(orderStatus.ExecutedAmount, 64)
-
-	return nil
+	order.DealRate = data.PriceAvg
+	order.DealQuantity = dealQuantity
 }
 
 func (e *Bitfinex) ListOrders() ([]*exchange.Order, error) {
-	return nil, nil
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	openOrders := OpenOrders{}
+	strRequest := "/v2/auth/r/orders"
+
+	jsonOpenOrders := e.ApiKeyPostV2(make(map[string]interface{}), strRequest)
+	if err := e.checkError(jsonOpenOrders); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Failed: %v", e.GetName(), err)
+	}
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &openOrders); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Unmarshal Err: %v %v", e.GetName(), err, jsonOpenOrders)
+	}
+
+	orders := []*exchange.Order{}
+	for i := range openOrders {
+		data := &openOrders[i]
+		order := &exchange.Order{
+			Pair:     e.GetPairBySymbol(strings.ToLower(strings.TrimPrefix(data.Symbol, "t"))),
+			OrderID:  fmt.Sprintf("%d", data.ID),
+			Rate:     decimal.NewFromFloat(data.Price),
+			Quantity: decimal.NewFromFloat(math.Abs(data.AmountOrig)),
+		}
+		if data.AmountOrig > 0 {
+			order.Side = "Buy"
+		} else {
+			order.Side = "Sell"
+		}
+		setOrderStatus(order, data)
+		orders = append(orders, order)
+	}
+
+	return orders, nil
 }
 
 func (e *Bitfinex) CancelOrder(order *exchange.Order) error {
@@ -422,17 +563,17 @@ func (e *Bitfinex) CancelOrder(order *exchange.Order) error {
 		return fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
-	cancelOrder := PlaceOrder{}
-	strRequest := "/v1/order/cancel"
+	orderID, err := strconv.ParseInt(order.OrderID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%s CancelOrder Invalid OrderID: %v", e.GetName(), order.OrderID)
+	}
 
 	mapParams := make(map[string]interface{})
-	mapParams["order_id"], _ = strconv.Atoi(order.OrderID)
+	mapParams["id"] = []int64{orderID}
 
-	jsonCancelOrder := e.ApiKeyPost(mapParams, strRequest)
-	if err := json.Unmarshal([]byte(jsonCancelOrder), &cancelOrder); err != nil {
-		return fmt.Errorf("%s CancelOrder Unmarshal Err: %v %v", e.GetName(), err, jsonCancelOrder)
-	} else if cancelOrder.ID == 0 {
-		return fmt.Errorf("%s CancelOrder Failed: %s", e.GetName(), jsonCancelOrder)
+	jsonCancelOrder, err := e.cancelMulti(mapParams)
+	if err != nil {
+		return fmt.Errorf("%s CancelOrder %v", e.GetName(), err)
 	}
 
 	order.Status = exchange.Canceling
@@ -442,6 +583,54 @@ func (e *Bitfinex) CancelOrder(order *exchange.Order) error {
 }
 
 func (e *Bitfinex) CancelAllOrder() error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	mapParams := make(map[string]interface{})
+	mapParams["all"] = 1
+
+	if _, err := e.cancelMulti(mapParams); err != nil {
+		return fmt.Errorf("%s CancelAllOrder %v", e.GetName(), err)
+	}
+
+	return nil
+}
+
+func (e *Bitfinex) cancelMulti(mapParams map[string]interface{}) (string, error) {
+	notification := Notification{}
+	strRequest := "/v2/auth/w/order/cancel/multi"
+
+	jsonCancelOrder := e.ApiKeyPostV2(mapParams, strRequest)
+	if err := e.checkError(jsonCancelOrder); err != nil {
+		return jsonCancelOrder, err
+	}
+	if err := json.Unmarshal([]byte(jsonCancelOrder), &notification); err != nil {
+		return jsonCancelOrder, fmt.Errorf("Unmarshal Err: %v %v", err, jsonCancelOrder)
+	} else if notification.Status != "SUCCESS" {
+		return jsonCancelOrder, fmt.Errorf("Failed: %v %v", notification.Status, notification.Text)
+	}
+
+	return jsonCancelOrder, nil
+}
+
+/* getTradingSymbol - v2 trading symbols are the v1 pair upper cased with a "t" prefix, eg: ethbtc -> tETHBTC */
+func (e *Bitfinex) getTradingSymbol(pair *pair.Pair) string {
+	return "t" + strings.ToUpper(e.GetSymbolByPair(pair))
+}
+
+/* checkError - v2 errors are returned as ["error", CODE, MESSAGE] */
+func (e *Bitfinex) checkError(jsonResponse string) error {
+	errResponse := ErrorResponse{}
+	if err := json.Unmarshal([]byte(jsonResponse), &errResponse); err != nil {
+		if !strings.HasPrefix(strings.TrimSpace(jsonResponse), "[") {
+			return fmt.Errorf("%v", jsonResponse)
+		}
+		return nil
+	}
+	if errResponse.Event == "error" {
+		return fmt.Errorf("%v %v", errResponse.Code, errResponse.Message)
+	}
 	return nil
 }
 
@@ -454,7 +643,7 @@ func (e *Bitfinex) ApiKeyPost(mapParams map[string]interface{}, strRequestPath s
 	strMethod := "POST"
 
 	mapParams["request"] = strRequestPath
-	mapParams["nonce"] = fmt.Sprintf("%v", time.Now().UnixNano())
+	mapParams["nonce"] = getNonce()
 
 	//Signature Request Params
 	payload, _ := json.Marshal(mapParams)
@@ -489,6 +678,47 @@ func (e *Bitfinex) ApiKeyPost(mapParams map[string]interface{}, strRequestPath s
 	return string(body)
 }
 
+/* ApiKeyPostV2 - v2 authenticated endpoints sign "/api" + path + nonce + body with HMAC-SHA384 */
+func (e *Bitfinex) ApiKeyPostV2(mapParams map[string]interface{}, strRequestPath string) string {
+	strMethod := "POST"
+	nonce := getNonce()
+
+	payload, _ := json.Marshal(mapParams)
+	Signature := ComputeHmac512_384NoDecode("/api"+strRequestPath+nonce+string(payload), e.API_SECRET)
+
+	strUrl := API_URL + strRequestPath
+
+	httpClient := &http.Client{}
+
+	request, err := http.NewRequest(strMethod, strUrl, bytes.NewReader(payload))
+	if nil != err {
+		return err.Error()
+	}
+	request.Header.Add("Content-Type", "application/json")
+	request.Header.Add("Accept", "application/json")
+	request.Header.Add("bfx-nonce", nonce)
+	request.Header.Add("bfx-apikey", e.API_KEY)
+	request.Header.Add("bfx-signature", Signature)
+
+	response, err := httpClient.Do(request)
+	if nil != err {
+		return err.Error()
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if nil != err {
+		return err.Error()
+	}
+
+	return string(body)
+}
+
+/* getNonce - v1 and v2 share the nonce of an API key, so both use the same microsecond counter */
+func getNonce() string {
+	return fmt.Sprintf("%v", time.Now().UnixNano()/1e3)
+}
+
 func ComputeHmac512_384NoDecode(strMessage string, strSecret string) string {
 	key := []byte(strSecret)
 	h := hmac.New(sha512.New384, key)
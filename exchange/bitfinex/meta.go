@@ -14,4 +14,8 @@ const (
 	DEFAULT_WITHDRAW     = true
 	DEFAULT_DEPOSIT      = true
 	DEFAULT_CONFIRMATION = 2
+
+	WALLET_EXCHANGE = "exchange"
+	WALLET_MARGIN   = "margin"
+	WALLET_FUNDING  = "funding"
 )
@@ -4,6 +4,12 @@ package bitfinex
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
 type CoinsData [][][]string
 
 type WithdrawFee struct {
@@ -34,34 +40,143 @@ type OrderBook struct {
 	} `json:"asks"`
 }
 
-type AccountBalances []struct {
-	Type      string `json:"type"`
-	Currency  string `json:"currency"`
-	Amount    string `json:"amount"`
-	Available string `json:"available"`
-}
-
-type PlaceOrder struct {
-	ID                int64       `json:"id"`
-	Cid               int         `json:"cid"`
-	CidDate           string      `json:"cid_date"`
-	Gid               interface{} `json:"gid"`
-	Symbol            string      `json:"symbol"`
-	Exchange          string      `json:"exchange"`
-	Price             string      `json:"price"`
-	AvgExecutionPrice string      `json:"avg_execution_price"`
-	Side              string      `json:"side"`
-	Type              string      `json:"type"`
-	Timestamp         string      `json:"timestamp"`
-	IsLive            bool        `json:"is_live"`
-	IsCancelled       bool        `json:"is_cancelled"`
-	IsHidden          bool        `json:"is_hidden"`
-	OcoOrder          interface{} `json:"oco_order"`
-	WasForced         bool        `json:"was_forced"`
-	OriginalAmount    string      `json:"original_amount"`
-	RemainingAmount   string      `json:"remaining_amount"`
-	ExecutedAmount    string      `json:"executed_amount"`
-	Src               string      `json:"src"`
-	OrderID           int64       `json:"order_id"`
-	Message           string      `json:"message"`
+/* v2 authenticated endpoints answer with positional arrays,
+the structs below are filled by UnmarshalJSON from the documented indexes */
+
+// ErrorResponse - ["error", CODE, MESSAGE]
+type ErrorResponse struct {
+	Event   string
+	Code    int64
+	Message string
+}
+
+// WalletData - [WALLET_TYPE, CURRENCY, BALANCE, UNSETTLED_INTEREST, AVAILABLE_BALANCE, ...]
+type WalletData struct {
+	Type              string
+	Currency          string
+	Balance           float64
+	UnsettledInterest float64
+	Available         float64
+}
+
+type AccountBalances []WalletData
+
+// OrderData - [ID, GID, CID, SYMBOL, MTS_CREATE, MTS_UPDATE, AMOUNT, AMOUNT_ORIG, TYPE, TYPE_PREV, MTS_TIF, _, FLAGS, STATUS, _, _, PRICE, PRICE_AVG, ...]
+type OrderData struct {
+	ID         int64
+	Symbol     string
+	Amount     float64
+	AmountOrig float64
+	Type       string
+	Status     string
+	Price      float64
+	PriceAvg   float64
+}
+
+type OpenOrders []OrderData
+
+// Notification - [MTS, TYPE, MESSAGE_ID, _, DATA, CODE, STATUS, TEXT]
+type Notification struct {
+	Type   string
+	Data   json.RawMessage
+	Status string
+	Text   string
+}
+
+func (r *ErrorResponse) UnmarshalJSON(b []byte) error {
+	raw := []interface{}{}
+	if err := decodeArray(b, &raw); err != nil {
+		return err
+	}
+	r.Event = arrayString(raw, 0)
+	r.Code = arrayInt(raw, 1)
+	r.Message = arrayString(raw, 2)
+	return nil
+}
+
+func (w *WalletData) UnmarshalJSON(b []byte) error {
+	raw := []interface{}{}
+	if err := decodeArray(b, &raw); err != nil {
+		return err
+	}
+	w.Type = arrayString(raw, 0)
+	w.Currency = arrayString(raw, 1)
+	w.Balance = arrayFloat(raw, 2)
+	w.UnsettledInterest = arrayFloat(raw, 3)
+	w.Available = arrayFloat(raw, 4)
+	return nil
+}
+
+func (o *OrderData) UnmarshalJSON(b []byte) error {
+	raw := []interface{}{}
+	if err := decodeArray(b, &raw); err != nil {
+		return err
+	}
+	o.ID = arrayInt(raw, 0)
+	o.Symbol = arrayString(raw, 3)
+	o.Amount = arrayFloat(raw, 6)
+	o.AmountOrig = arrayFloat(raw, 7)
+	o.Type = arrayString(raw, 8)
+	o.Status = arrayString(raw, 13)
+	o.Price = arrayFloat(raw, 16)
+	o.PriceAvg = arrayFloat(raw, 17)
+	return nil
+}
+
+func (n *Notification) UnmarshalJSON(b []byte) error {
+	raw := []json.RawMessage{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if len(raw) < 8 {
+		return fmt.Errorf("notification has %d fields: %s", len(raw), b)
+	}
+	json.Unmarshal(raw[1], &n.Type)
+	n.Data = raw[4]
+	json.Unmarshal(raw[6], &n.Status)
+	json.Unmarshal(raw[7], &n.Text)
+	return nil
+}
+
+func decodeArray(b []byte, raw *[]interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	return decoder.Decode(raw)
+}
+
+func arrayString(raw []interface{}, i int) string {
+	if i < len(raw) {
+		if s, ok := raw[i].(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
+func arrayInt(raw []interface{}, i int) int64 {
+	if i < len(raw) {
+		if n, ok := raw[i].(json.Number); ok {
+			v, _ := n.Int64()
+			return v
+		}
+	}
+	return 0
+}
+
+func arrayFloat(raw []interface{}, i int) float64 {
+	if i < len(raw) {
+		if n, ok := raw[i].(json.Number); ok {
+			v, _ := n.Float64()
+			return v
+		}
+	}
+	return 0
+}
+
+type WithdrawMethods [][][]json.RawMessage
+
+type WithdrawResponse []struct {
+	Status       string `json:"status"`
+	Message      string `json:"message"`
+	WithdrawalID int    `json:"withdrawal_id"`
 }
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -72,17 +72,17 @@ func (e *Bitforex) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(symbols[1])
+			base = exchange.GetCoin(e.GetName(), symbols[1])
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = symbols[1]
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), symbols[1])
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(symbols[2])
+			target = exchange.GetCoin(e.GetName(), symbols[2])
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = symbols[2]
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), symbols[2])
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(symbols[1])
@@ -148,8 +148,8 @@ func (e *Bitforex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(symbols[1])
-			target := coin.GetCoin(symbols[2])
+			base := exchange.GetCoin(e.GetName(), symbols[1])
+			target := exchange.GetCoin(e.GetName(), symbols[2])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -163,8 +163,8 @@ func (e *Bitforex) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.AmountPrecision),
-				PriceFilter: math.Pow10(-1 * data.PricePrecision),
+				LotSize:     decimal.New(1, int32(-1*data.AmountPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.PricePrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -209,14 +209,14 @@ func (e *Bitforex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	maker.AfterTimestamp = float64(time.Now().UnixNano() / 1e6)
 	for _, bid := range orderBook.Bids {
 		var buydata exchange.Order
-		buydata.Quantity = bid.Amount
-		buydata.Rate = bid.Price
+		buydata.Quantity = decimal.NewFromFloat(bid.Amount)
+		buydata.Rate = decimal.NewFromFloat(bid.Price)
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for i := len(orderBook.Asks) - 1; i > 0; i-- {
 		var selldata exchange.Order
-		selldata.Quantity = orderBook.Asks[i].Amount
-		selldata.Rate = orderBook.Asks[i].Price
+		selldata.Quantity = decimal.NewFromFloat(orderBook.Asks[i].Amount)
+		selldata.Rate = decimal.NewFromFloat(orderBook.Asks[i].Price)
 		maker.Asks = append(maker.Asks, selldata)
 	}
 	return maker, nil
@@ -256,13 +256,14 @@ func (e *Bitforex) UpdateAllBalances() {
 				log.Printf("%s free balance parse Err: %v %s", e.GetName(), err, v.Active)
 				return
 			}
-			balanceMap.Set(c.Code, freeamount)
+			e.balanceMap.Set(c.Code, freeamount)
 		}
 	}
 }
 
+// Withdraw - not viable, the v1 API only has fund balance (/v1/fund) and trade (/v1/trade) endpoints, there is no withdraw endpoint
 func (e *Bitforex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -271,14 +272,19 @@ func (e *Bitforex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/trade/placeOrder"
 
 	mapParams := make(map[string]interface{})
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 	mapParams["tradeType"] = "2"
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
@@ -294,8 +300,8 @@ func (e *Bitforex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -309,14 +315,19 @@ func (e *Bitforex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/trade/placeOrder"
 
 	mapParams := make(map[string]interface{})
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 	mapParams["tradeType"] = "1"
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
@@ -332,8 +343,8 @@ func (e *Bitforex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
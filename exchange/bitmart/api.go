@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -64,12 +64,12 @@ func (e *Bitmart) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.ID)
+			c = exchange.GetCoin(e.GetName(), data.ID)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.ID
+				c.Code = exchange.GetCoinCode(e.GetName(), data.ID)
 				c.Name = data.Name
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.ID)
@@ -112,8 +112,8 @@ func (e *Bitmart) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteCurrency)
-			target := coin.GetCoin(data.BaseCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 			if base != nil && target != nil {
 
 				p = pair.GetPair(base, target)
@@ -123,7 +123,7 @@ func (e *Bitmart) GetPairsData() error {
 			p = e.GetPairBySymbol(data.ID)
 		}
 		if p != nil {
-			lotSize, err := strconv.ParseFloat(data.QuoteIncrement, 64)
+			lotSize, err := decimal.NewFromString(data.QuoteIncrement)
 			if err != nil {
 				return fmt.Errorf("%s lotSize parse error: %v, %v", e.GetName(), err, data.QuoteIncrement)
 			}
@@ -134,7 +134,7 @@ func (e *Bitmart) GetPairsData() error {
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
 				LotSize:     lotSize,
-				PriceFilter: math.Pow10(-1 * data.PriceMaxPrecision),
+				PriceFilter: decimal.New(1, int32(-1*data.PriceMaxPrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -172,11 +172,11 @@ func (e *Bitmart) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Amount, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -187,11 +187,11 @@ func (e *Bitmart) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Amount, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -225,15 +225,16 @@ func (e *Bitmart) UpdateAllBalances() {
 				log.Printf("%s balance parse Err: %v %v", e.GetName(), err, balance.Available)
 				return
 			}
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 
 	}
 
 }
 
+// Withdraw - not viable, the v2 open API only has wallet and order endpoints, there is no withdraw endpoint
 func (e *Bitmart) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -242,17 +243,19 @@ func (e *Bitmart) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/v2/orders"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "sell"
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -264,8 +267,8 @@ func (e *Bitmart) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.EntrustID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -279,17 +282,19 @@ func (e *Bitmart) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/v2/orders"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "buy"
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -301,8 +306,8 @@ func (e *Bitmart) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.EntrustID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
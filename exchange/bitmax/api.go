@@ -12,7 +12,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -21,6 +20,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -67,12 +67,12 @@ func (e *Bitmax) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.AssetCode)
+			c = exchange.GetCoin(e.GetName(), data.AssetCode)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.AssetCode
+				c.Code = exchange.GetCoinCode(e.GetName(), data.AssetCode)
 				c.Name = data.AssetName
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.AssetCode)
@@ -125,8 +125,8 @@ func (e *Bitmax) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteAsset)
-			target := coin.GetCoin(data.BaseAsset)
+			base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+			target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -134,14 +134,20 @@ func (e *Bitmax) GetPairsData() error {
 			p = e.GetPairBySymbol(data.Symbol)
 		}
 		if p != nil {
+			minNotional, _ := decimal.NewFromString(data.MinNotional)
+			minQty, _ := decimal.NewFromString(data.MinQty)
+			maxQty, _ := decimal.NewFromString(data.MaxQty)
 			pairConstraint := &exchange.PairConstraint{
 				PairID:      p.ID,
 				Pair:        p,
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.QtyScale),
-				PriceFilter: math.Pow10(-1 * data.PriceScale),
+				LotSize:     decimal.New(1, int32(-1*data.QtyScale)),
+				PriceFilter: decimal.New(1, int32(-1*data.PriceScale)),
+				MinNotional: minNotional,
+				MinQuantity: minQty,
+				MaxQuantity: maxQty,
 				Listed:      true,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -182,11 +188,11 @@ func (e *Bitmax) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
 			return nil, err
 		}
@@ -194,11 +200,11 @@ func (e *Bitmax) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	}
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Rate, err = strconv.ParseFloat(ask[0], 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0])
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1], 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1])
 		if err != nil {
 			return nil, err
 		}
@@ -258,7 +264,7 @@ func (e *Bitmax) UpdateAllBalances() {
 
 		c := e.GetCoinBySymbol(freeBalance.AssetCode)
 		if c != nil {
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 
 	}
@@ -271,6 +277,11 @@ func (e *Bitmax) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	// API 1.2 be deprecated
 	/* withdraw := Withdrawal{}
 	strRequest := fmt.Sprintf("/%v/api/v1/withdraw", e.Account_Group)
@@ -298,6 +309,11 @@ func (e *Bitmax) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestUrl := fmt.Sprintf("/%v/api/v1/order", e.Account_Group)
@@ -305,8 +321,8 @@ func (e *Bitmax) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	mapParams := make(map[string]string)
 	mapParams["coid"] = fmt.Sprintf("%v%v", time.Now().UTC().UnixNano(), time.Now().UTC().UnixNano()/1000000)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["orderPrice"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["orderQty"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["orderPrice"] = orderRate.String()
+	mapParams["orderQty"] = orderQuantity.String()
 	mapParams["orderType"] = "limit"
 	mapParams["side"] = "sell"
 
@@ -325,8 +341,8 @@ func (e *Bitmax) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.Coid,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -340,6 +356,11 @@ func (e *Bitmax) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestUrl := fmt.Sprintf("/%v/api/v1/order", e.Account_Group)
@@ -347,8 +368,8 @@ func (e *Bitmax) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	mapParams := make(map[string]string)
 	mapParams["coid"] = fmt.Sprintf("%v%v", time.Now().UTC().UnixNano(), time.Now().UTC().UnixNano()/1000000)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["orderPrice"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["orderQty"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["orderPrice"] = orderRate.String()
+	mapParams["orderQty"] = orderQuantity.String()
 	mapParams["orderType"] = "limit"
 	mapParams["side"] = "buy"
 
@@ -367,8 +388,8 @@ func (e *Bitmax) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.Coid,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -65,18 +66,18 @@ func (e *Bitmex) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.QuoteCurrency)
+			base = exchange.GetCoin(e.GetName(), data.QuoteCurrency)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.QuoteCurrency
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.QuoteCurrency)
+				base = coin.GetOrAddCoin(base)
 			}
 
-			target = coin.GetCoin(data.RootSymbol)
+			target = exchange.GetCoin(e.GetName(), data.RootSymbol)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.RootSymbol
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.RootSymbol)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.QuoteCurrency)
@@ -137,8 +138,8 @@ func (e *Bitmex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteCurrency)
-			target := coin.GetCoin(data.RootSymbol)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+			target := exchange.GetCoin(e.GetName(), data.RootSymbol)
 			if base != nil && target != nil && base != target {
 				p = pair.GetPair(base, target)
 			}
@@ -153,8 +154,8 @@ func (e *Bitmex) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    data.MakerFee,
 				TakerFee:    data.TakerFee,
-				LotSize:     data.LotSize,
-				PriceFilter: data.TickSize,
+				LotSize:     decimal.NewFromFloat(data.LotSize),
+				PriceFilter: decimal.NewFromFloat(data.TickSize),
 				Listed:      true,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -200,8 +201,8 @@ func (e *Bitmex) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 		if bid.Side == "Buy" {
 			buydata := exchange.Order{}
 
-			buydata.Rate = bid.Price
-			buydata.Quantity = bid.Size / bid.Price
+			buydata.Rate = decimal.NewFromFloat(bid.Price)
+			buydata.Quantity = decimal.NewFromFloat(bid.Size / bid.Price)
 
 			maker.Bids = append(maker.Bids, buydata)
 		}
@@ -210,8 +211,8 @@ func (e *Bitmex) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 		if orderBook[i].Side == "Sell" {
 			selldata := exchange.Order{}
 
-			selldata.Rate = orderBook[i].Price
-			selldata.Quantity = orderBook[i].Size / orderBook[i].Price
+			selldata.Rate = decimal.NewFromFloat(orderBook[i].Price)
+			selldata.Quantity = decimal.NewFromFloat(orderBook[i].Size / orderBook[i].Price)
 
 			maker.Asks = append(maker.Asks, selldata)
 		}
@@ -243,6 +244,11 @@ func (e *Bitmex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	errResponse := ErrorResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v1/order"
@@ -250,8 +256,8 @@ func (e *Bitmex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "Sell"
-	mapParams["simpleOrderQty"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["simpleOrderQty"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -265,8 +271,8 @@ func (e *Bitmex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 			Pair:         pair,
 			Side:         "Sell",
 			OrderID:      placeOrder.OrderID,
-			Rate:         rate,
-			Quantity:     quantity,
+			Rate:         orderRate,
+			Quantity:     orderQuantity,
 			Status:       exchange.New,
 			JsonResponse: jsonPlaceReturn,
 		}
@@ -279,6 +285,11 @@ func (e *Bitmex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	errResponse := ErrorResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v1/order"
@@ -286,8 +297,8 @@ func (e *Bitmex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "Buy"
-	mapParams["simpleOrderQty"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["simpleOrderQty"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -301,8 +312,8 @@ func (e *Bitmex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 			Pair:         pair,
 			Side:         "Buy",
 			OrderID:      placeOrder.OrderID,
-			Rate:         rate,
-			Quantity:     quantity,
+			Rate:         orderRate,
+			Quantity:     orderQuantity,
 			Status:       exchange.New,
 			JsonResponse: jsonPlaceReturn,
 		}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -17,6 +16,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -70,17 +70,17 @@ func (e *Bitrue) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.QuoteAsset)
+			base = exchange.GetCoin(e.GetName(), data.QuoteAsset)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.QuoteAsset
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.QuoteAsset)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseAsset)
+			target = exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseAsset
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseAsset)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.QuoteAsset)
@@ -145,8 +145,8 @@ func (e *Bitrue) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteAsset)
-			target := coin.GetCoin(data.BaseAsset)
+			base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+			target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if base != nil && target != nil {
 
 				p = pair.GetPair(base, target)
@@ -162,8 +162,8 @@ func (e *Bitrue) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.BaseAssetPrecision),
-				PriceFilter: math.Pow10(-1 * data.QuotePrecision),
+				LotSize:     decimal.New(1, int32(-1*data.BaseAssetPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.QuotePrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -209,27 +209,27 @@ func (e *Bitrue) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1].(string), 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		buydata.Rate, err = strconv.ParseFloat(bid[0].(string), 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1].(string), 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		selldata.Rate, err = strconv.ParseFloat(ask[0].(string), 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0].(string))
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -268,14 +268,51 @@ func (e *Bitrue) UpdateAllBalances() {
 				log.Printf("%s balance parse Err: %v %v", e.GetName(), err, v.Free)
 				return
 			}
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
 
 func (e *Bitrue) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	jsonResponse := &JsonResponse{}
+	withdraw := WithdrawResponse{}
+	strRequest := "/api/v1/withdraw/commit"
 
-	return false
+	mapParams := make(map[string]string)
+	mapParams["coin"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["addressTo"] = addr
+	if tag != "" {
+		mapParams["tag"] = tag
+	}
+
+	jsonSubmitWithdraw := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &jsonResponse); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if jsonResponse.Code != 200 {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonResponse.Message)
+		return false
+	}
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Data Unmarshal Err: %v %s", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if withdraw.Data.WithdrawID == 0 {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonSubmitWithdraw)
+		return false
+	}
+
+	return true
 }
 
 func (e *Bitrue) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -283,19 +320,21 @@ func (e *Bitrue) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v1/order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -310,8 +349,8 @@ func (e *Bitrue) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprint(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -325,19 +364,21 @@ func (e *Bitrue) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v1/order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -352,8 +393,8 @@ func (e *Bitrue) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprint(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
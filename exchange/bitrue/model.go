@@ -68,6 +68,16 @@ type PlaceOrder struct {
 	TransactTime  int64  `json:"transactTime"`
 }
 
+type WithdrawResponse struct {
+	Data struct {
+		WithdrawID int64   `json:"withdrawId"`
+		Coin       string  `json:"coin"`
+		Amount     float64 `json:"amount"`
+		Fee        float64 `json:"fee"`
+		AddressTo  string  `json:"addressTo"`
+	} `json:"data"`
+}
+
 type OrderStatus struct {
 	Symbol              string `json:"symbol"`
 	OrderID             string `json:"orderId"`
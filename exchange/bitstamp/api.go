@@ -8,15 +8,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"math"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -67,19 +66,19 @@ func (e *Bitstamp) GetCoinsData() error {
 
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(coinStrs[1])
+			base = exchange.GetCoin(e.GetName(), coinStrs[1])
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = coinStrs[1]
+				base.Code = exchange.GetCoinCode(e.GetName(), coinStrs[1])
 				base.Name = coinNameStrs[1]
-				coin.AddCoin(base)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(coinStrs[0])
+			target = exchange.GetCoin(e.GetName(), coinStrs[0])
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = coinStrs[0]
+				target.Code = exchange.GetCoinCode(e.GetName(), coinStrs[0])
 				target.Name = coinNameStrs[0]
-				coin.AddCoin(target)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(coinStrs[1])
@@ -144,8 +143,8 @@ func (e *Bitstamp) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(pairStrs[1])
-			target := coin.GetCoin(pairStrs[0])
+			base := exchange.GetCoin(e.GetName(), pairStrs[1])
+			target := exchange.GetCoin(e.GetName(), pairStrs[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -159,8 +158,8 @@ func (e *Bitstamp) GetPairsData() error {
 				ExSymbol:    data.URLSymbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.BaseDecimals),
-				PriceFilter: math.Pow10(-1 * data.CounterDecimals),
+				LotSize:     decimal.New(1, int32(-1*data.BaseDecimals)),
+				PriceFilter: decimal.New(1, int32(-1*data.CounterDecimals)),
 				Listed:      true,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -199,11 +198,11 @@ func (e *Bitstamp) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		buydata := exchange.Order{}
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
 			return nil, err
 		}
@@ -214,11 +213,11 @@ func (e *Bitstamp) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		selldata := exchange.Order{}
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0], 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0])
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1], 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1])
 		if err != nil {
 			return nil, err
 		}
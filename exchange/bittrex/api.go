@@ -5,22 +5,27 @@ package bittrex
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
-
-	"strconv"
+	"github.com/shopspring/decimal"
 )
 
 const (
-	API_URL string = "https://bittrex.com/api"
+	API_URL string = "https://api.bittrex.com/v3"
 )
 
 /*API Base Knowledge
@@ -49,48 +54,48 @@ Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
 Step 3: Modify API Path(strRequestUrl)*/
 func (e *Bittrex) GetCoinsData() error {
-	jsonResponse := &JsonResponse{}
+	errResponse := ErrorResponse{}
 	coinsData := CoinsData{}
 
-	strRequestUrl := "/v1.1/public/getcurrencies"
+	strRequestUrl := "/currencies"
 	strUrl := API_URL + strRequestUrl
 
 	jsonCurrencyReturn := exchange.HttpGetRequest(strUrl, nil)
-	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &jsonResponse); err != nil {
-		return fmt.Errorf("%s Get Coins Json Unmarshal Err: %v %v", e.GetName(), err, jsonCurrencyReturn)
-	} else if !jsonResponse.Success {
-		return fmt.Errorf("%s Get Coins Failed: %v", e.GetName(), jsonResponse.Message)
+	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &errResponse); err == nil && errResponse.Code != "" {
+		return fmt.Errorf("%s Get Coins Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &coinsData); err != nil {
-		return fmt.Errorf("%s Get Coins Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &coinsData); err != nil {
+		return fmt.Errorf("%s Get Coins Json Unmarshal Err: %v %v", e.GetName(), err, jsonCurrencyReturn)
 	}
 
 	for _, data := range coinsData {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Currency)
+			c = exchange.GetCoin(e.GetName(), data.Symbol)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Currency
-				c.Name = data.CurrencyLong
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), data.Symbol)
+				c.Name = data.Name
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
-			c = e.GetCoinBySymbol(data.Currency)
+			c = e.GetCoinBySymbol(data.Symbol)
 		}
 
 		if c != nil {
+			txFee, _ := strconv.ParseFloat(data.TxFee, 64)
 			coinConstraint := &exchange.CoinConstraint{
 				CoinID:       c.ID,
 				Coin:         c,
-				ExSymbol:     data.Currency,
+				ExSymbol:     data.Symbol,
 				ChainType:    exchange.MAINNET,
-				TxFee:        data.TxFee,
-				Withdraw:     data.IsActive,
-				Deposit:      data.IsActive,
-				Confirmation: data.MinConfirmation,
+				TxFee:        txFee,
+				Withdraw:     data.Status == "ONLINE",
+				Deposit:      data.Status == "ONLINE",
+				Confirmation: data.MinConfirmations,
 				Listed:       true,
+				Issue:        data.Notice,
 			}
 			e.SetCoinConstraint(coinConstraint)
 		}
@@ -103,46 +108,49 @@ Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
 Step 3: Modify API Path(strRequestUrl)*/
 func (e *Bittrex) GetPairsData() error {
-	jsonResponse := &JsonResponse{}
+	errResponse := ErrorResponse{}
 	pairsData := PairsData{}
 
-	strRequestUrl := "/v1.1/public/getmarkets"
+	strRequestUrl := "/markets"
 	strUrl := API_URL + strRequestUrl
 
 	jsonSymbolsReturn := exchange.HttpGetRequest(strUrl, nil)
-	if err := json.Unmarshal([]byte(jsonSymbolsReturn), &jsonResponse); err != nil {
-		return fmt.Errorf("%s Get Pairs Json Unmarshal Err: %v %v", e.GetName(), err, jsonSymbolsReturn)
-	} else if !jsonResponse.Success {
-		return fmt.Errorf("%s Get Pairs Failed: %v", e.GetName(), jsonResponse.Message)
+	if err := json.Unmarshal([]byte(jsonSymbolsReturn), &errResponse); err == nil && errResponse.Code != "" {
+		return fmt.Errorf("%s Get Pairs Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &pairsData); err != nil {
-		return fmt.Errorf("%s Get Pairs Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonSymbolsReturn), &pairsData); err != nil {
+		return fmt.Errorf("%s Get Pairs Json Unmarshal Err: %v %v", e.GetName(), err, jsonSymbolsReturn)
 	}
 
 	for _, data := range pairsData {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.BaseCurrency)
-			target := coin.GetCoin(data.MarketCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrencySymbol)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrencySymbol)
 			if base != nil && target != nil {
-
 				p = pair.GetPair(base, target)
-
 			}
 		case exchange.JSON_FILE:
-			p = e.GetPairBySymbol(data.MarketName)
+			p = e.GetPairBySymbol(data.Symbol)
 		}
 		if p != nil {
+			priceFilter := DEFAULT_PRICE_FILTER
+			if data.Precision > 0 {
+				priceFilter = math.Pow10(-data.Precision)
+			}
+			minTradeSize, _ := decimal.NewFromString(data.MinTradeSize)
 			pairConstraint := &exchange.PairConstraint{
 				PairID:      p.ID,
 				Pair:        p,
-				ExSymbol:    data.MarketName,
+				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
-				Listed:      true,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(priceFilter),
+				MinQuantity: minTradeSize,
+				Listed:      data.Status == "ONLINE",
+				Issue:       data.Notice,
 			}
 			e.SetPairConstraint(pairConstraint)
 		}
@@ -158,15 +166,14 @@ Step 4: Modify API Path(strRequestUrl)
 Step 5: Add Params - Depend on API request
 Step 6: Convert the response to Standard Maker struct*/
 func (e *Bittrex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
-	jsonResponse := &JsonResponse{}
+	errResponse := ErrorResponse{}
 	orderBook := OrderBook{}
 	symbol := e.GetSymbolByPair(pair)
 
 	mapParams := make(map[string]string)
-	mapParams["market"] = symbol
-	mapParams["type"] = "both"
+	mapParams["depth"] = "500"
 
-	strRequestUrl := "/v1.1/public/getorderbook"
+	strRequestUrl := fmt.Sprintf("/markets/%s/orderbook", symbol)
 	strUrl := API_URL + strRequestUrl
 
 	maker := &exchange.Maker{}
@@ -174,21 +181,39 @@ func (e *Bittrex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	maker.BeforeTimestamp = float64(time.Now().UnixNano() / 1e6)
 
 	jsonOrderbook := exchange.HttpGetRequest(strUrl, mapParams)
-	if err := json.Unmarshal([]byte(jsonOrderbook), &jsonResponse); err != nil {
-		return nil, fmt.Errorf("%s Get Orderbook Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderbook)
-	} else if !jsonResponse.Success {
-		return nil, fmt.Errorf("%s Get Orderbook Failed: %v", e.GetName(), jsonResponse.Message)
+	if err := json.Unmarshal([]byte(jsonOrderbook), &errResponse); err == nil && errResponse.Code != "" {
+		return nil, fmt.Errorf("%s Get Orderbook Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &orderBook); err != nil {
-		return nil, fmt.Errorf("%s Get Orderbook Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonOrderbook), &orderBook); err != nil {
+		return nil, fmt.Errorf("%s Get Orderbook Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderbook)
 	}
 
 	maker.AfterTimestamp = float64(time.Now().UnixNano() / 1e6)
-	for _, bid := range orderBook.Buy {
-		maker.Bids = append(maker.Bids, bid)
+
+	var err error
+	for _, bid := range orderBook.Bid {
+		buydata := exchange.Order{}
+		buydata.Rate, err = decimal.NewFromString(bid.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
+		}
+		buydata.Quantity, err = decimal.NewFromString(bid.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
+		}
+		maker.Bids = append(maker.Bids, buydata)
 	}
-	for _, ask := range orderBook.Sell {
-		maker.Asks = append(maker.Asks, ask)
+	for _, ask := range orderBook.Ask {
+		selldata := exchange.Order{}
+		selldata.Rate, err = decimal.NewFromString(ask.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
+		}
+		selldata.Quantity, err = decimal.NewFromString(ask.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
+		}
+		maker.Asks = append(maker.Asks, selldata)
 	}
 	return maker, nil
 }
@@ -200,27 +225,29 @@ func (e *Bittrex) UpdateAllBalances() {
 		return
 	}
 
-	jsonResponse := &JsonResponse{}
+	errResponse := ErrorResponse{}
 	accountBalance := AccountBalances{}
-	strRequest := "/v1.1/account/getbalances"
+	strRequest := "/balances"
 
-	jsonBalanceReturn := e.ApiKeyGET(strRequest, make(map[string]string))
-	if err := json.Unmarshal([]byte(jsonBalanceReturn), &jsonResponse); err != nil {
-		log.Printf("%s UpdateAllBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
-		return
-	} else if !jsonResponse.Success {
-		log.Printf("%s UpdateAllBalances Failed: %v", e.GetName(), jsonResponse.Message)
+	jsonBalanceReturn := e.ApiKeyRequest("GET", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &errResponse); err == nil && errResponse.Code != "" {
+		log.Printf("%s UpdateAllBalances Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 		return
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &accountBalance); err != nil {
-		log.Printf("%s UpdateAllBalances Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &accountBalance); err != nil {
+		log.Printf("%s UpdateAllBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
 		return
 	}
 
 	for _, v := range accountBalance {
-		c := e.GetCoinBySymbol(v.Currency)
+		c := e.GetCoinBySymbol(v.CurrencySymbol)
 		if c != nil {
-			balanceMap.Set(c.Code, v.Available)
+			freeamount, err := strconv.ParseFloat(v.Available, 64)
+			if err != nil {
+				log.Printf("%s UpdateAllBalances err: %+v %v", e.GetName(), v, err)
+				continue
+			}
+			e.balanceMap.Set(c.Code, freeamount)
 		}
 	}
 }
@@ -231,25 +258,33 @@ func (e *Bittrex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string)
 		return false
 	}
 
-	mapParams := make(map[string]string)
-	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	mapParams := make(map[string]interface{})
+	mapParams["currencySymbol"] = e.GetSymbolByCoin(coin)
 	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["address"] = addr
+	mapParams["cryptoAddress"] = addr
+	if tag != "" {
+		mapParams["cryptoAddressTag"] = tag
+	}
 
-	jsonResponse := &JsonResponse{}
-	uuid := Uuid{}
-	strRequest := "/v1.1/account/withdraw"
+	errResponse := ErrorResponse{}
+	withdraw := WithdrawResponse{}
+	strRequest := "/withdrawals"
 
-	jsonSubmitWithdraw := e.ApiKeyGET(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &jsonResponse); err != nil {
-		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
-		return false
-	} else if !jsonResponse.Success {
-		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonResponse.Message)
+	jsonSubmitWithdraw := e.ApiKeyRequest("POST", strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &errResponse); err == nil && errResponse.Code != "" {
+		log.Printf("%s Withdraw Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 		return false
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &uuid); err != nil {
-		log.Printf("%s Withdraw Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if withdraw.ID == "" {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonSubmitWithdraw)
 		return false
 	}
 	return true
@@ -260,30 +295,21 @@ func (e *Bittrex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	mapParams := make(map[string]string)
-	mapParams["market"] = e.GetSymbolByPair(pair)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
-
-	jsonResponse := &JsonResponse{}
-	uuid := Uuid{}
-	strRequest := "/v1.1/market/selllimit"
-
-	jsonPlaceReturn := e.ApiKeyGET(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Json Unmarshal Err: %v %v", e.GetName(), err, jsonPlaceReturn)
-	} else if !jsonResponse.Success {
-		return nil, fmt.Errorf("%s LimitSell Failed: %v", e.GetName(), jsonResponse.Message)
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &uuid); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+
+	placeOrder, jsonPlaceReturn, err := e.placeOrder(pair, "SELL", orderQuantity, orderRate)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitSell %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      uuid.Id,
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      placeOrder.ID,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -297,30 +323,21 @@ func (e *Bittrex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	mapParams := make(map[string]string)
-	mapParams["market"] = e.GetSymbolByPair(pair)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
-
-	jsonResponse := &JsonResponse{}
-	uuid := Uuid{}
-	strRequest := "/v1.1/market/buylimit"
-
-	jsonPlaceReturn := e.ApiKeyGET(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
-		return nil, fmt.Errorf("%s LimitBuy Json Unmarshal Err: %v %v", e.GetName(), err, jsonPlaceReturn)
-	} else if !jsonResponse.Success {
-		return nil, fmt.Errorf("%s LimitBuy Failed: %v", e.GetName(), jsonResponse.Message)
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &uuid); err != nil {
-		return nil, fmt.Errorf("%s LimitBuy Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+
+	placeOrder, jsonPlaceReturn, err := e.placeOrder(pair, "BUY", orderQuantity, orderRate)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitBuy %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      uuid.Id,
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      placeOrder.ID,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -328,46 +345,117 @@ func (e *Bittrex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	return order, nil
 }
 
+func (e *Bittrex) placeOrder(pair *pair.Pair, direction string, quantity, rate decimal.Decimal) (*PlaceOrder, string, error) {
+	mapParams := make(map[string]interface{})
+	mapParams["marketSymbol"] = e.GetSymbolByPair(pair)
+	mapParams["direction"] = direction
+	mapParams["type"] = "LIMIT"
+	mapParams["quantity"] = quantity.String()
+	mapParams["limit"] = rate.String()
+	mapParams["timeInForce"] = "GOOD_TIL_CANCELLED"
+
+	errResponse := ErrorResponse{}
+	placeOrder := &PlaceOrder{}
+	strRequest := "/orders"
+
+	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &errResponse); err == nil && errResponse.Code != "" {
+		return nil, jsonPlaceReturn, fmt.Errorf("Failed: %v %v", errResponse.Code, errResponse.Detail)
+	}
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), placeOrder); err != nil {
+		return nil, jsonPlaceReturn, fmt.Errorf("Json Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	}
+
+	return placeOrder, jsonPlaceReturn, nil
+}
+
 func (e *Bittrex) OrderStatus(order *exchange.Order) error {
 	if e.API_KEY == "" || e.API_SECRET == "" {
 		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	mapParams := make(map[string]string)
-	mapParams["uuid"] = order.OrderID
-
-	jsonResponse := &JsonResponse{}
+	errResponse := ErrorResponse{}
 	orderStatus := PlaceOrder{}
-	strRequest := "/v1.1/account/getorder"
+	strRequest := fmt.Sprintf("/orders/%s", order.OrderID)
 
-	jsonOrderStatus := e.ApiKeyGET(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonOrderStatus), &jsonResponse); err != nil {
-		return fmt.Errorf("%s OrderStatus Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderStatus)
-	} else if !jsonResponse.Success {
-		return fmt.Errorf("%s OrderStatus Failed: %v", e.GetName(), jsonResponse.Message)
+	jsonOrderStatus := e.ApiKeyRequest("GET", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonOrderStatus), &errResponse); err == nil && errResponse.Code != "" {
+		return fmt.Errorf("%s OrderStatus Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &orderStatus); err != nil {
-		return fmt.Errorf("%s OrderStatus Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonOrderStatus), &orderStatus); err != nil {
+		return fmt.Errorf("%s OrderStatus Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderStatus)
 	}
 
 	order.StatusMessage = jsonOrderStatus
-	if orderStatus.CancelInitiated {
-		order.Status = exchange.Canceling
-	} else if !orderStatus.IsOpen && orderStatus.QuantityRemaining > 0 {
-		order.Status = exchange.Canceled
-	} else if orderStatus.QuantityRemaining == 0 {
-		order.Status = exchange.Filled
-	} else if orderStatus.QuantityRemaining != orderStatus.Quantity {
-		order.Status = exchange.Partial
+	setOrderStatus(order, &orderStatus)
+
+	return nil
+}
+
+func setOrderStatus(order *exchange.Order, orderStatus *PlaceOrder) {
+	quantity, _ := strconv.ParseFloat(orderStatus.Quantity, 64)
+	fillQuantity, _ := strconv.ParseFloat(orderStatus.FillQuantity, 64)
+	proceeds, _ := strconv.ParseFloat(orderStatus.Proceeds, 64)
+
+	if orderStatus.Status == "CLOSED" {
+		if fillQuantity >= quantity {
+			order.Status = exchange.Filled
+		} else {
+			order.Status = exchange.Canceled
+		}
+	} else if orderStatus.Status == "OPEN" {
+		if fillQuantity > 0 {
+			order.Status = exchange.Partial
+		} else {
+			order.Status = exchange.New
+		}
 	} else {
-		order.Status = exchange.New
+		order.Status = exchange.Other
 	}
 
-	return nil
+	order.DealQuantity = fillQuantity
+	if fillQuantity > 0 {
+		order.DealRate = proceeds / fillQuantity
+	}
 }
 
 func (e *Bittrex) ListOrders() ([]*exchange.Order, error) {
-	return nil, nil
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	openOrders := OpenOrders{}
+	strRequest := "/orders/open"
+
+	jsonOpenOrders := e.ApiKeyRequest("GET", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &errResponse); err == nil && errResponse.Code != "" {
+		return nil, fmt.Errorf("%s ListOrders Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
+	}
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &openOrders); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Json Unmarshal Err: %v %v", e.GetName(), err, jsonOpenOrders)
+	}
+
+	orders := []*exchange.Order{}
+	for _, data := range openOrders {
+		rate, _ := strconv.ParseFloat(data.Limit, 64)
+		quantity, _ := strconv.ParseFloat(data.Quantity, 64)
+		order := &exchange.Order{
+			Pair:     e.GetPairBySymbol(data.MarketSymbol),
+			OrderID:  data.ID,
+			Rate:     decimal.NewFromFloat(rate),
+			Quantity: decimal.NewFromFloat(quantity),
+		}
+		if data.Direction == "BUY" {
+			order.Side = "Buy"
+		} else {
+			order.Side = "Sell"
+		}
+		setOrderStatus(order, &data)
+		orders = append(orders, order)
+	}
+
+	return orders, nil
 }
 
 func (e *Bittrex) CancelOrder(order *exchange.Order) error {
@@ -375,21 +463,16 @@ func (e *Bittrex) CancelOrder(order *exchange.Order) error {
 		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	mapParams := make(map[string]string)
-	mapParams["uuid"] = order.OrderID
-
-	jsonResponse := &JsonResponse{}
+	errResponse := ErrorResponse{}
 	cancelOrder := PlaceOrder{}
-	strRequest := "/v1.1/market/cancel"
+	strRequest := fmt.Sprintf("/orders/%s", order.OrderID)
 
-	jsonCancelOrder := e.ApiKeyGET(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonCancelOrder), &jsonResponse); err != nil {
-		return fmt.Errorf("%s CancelOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonCancelOrder)
-	} else if !jsonResponse.Success {
-		return fmt.Errorf("%s CancelOrder Failed: %v", e.GetName(), jsonResponse.Message)
+	jsonCancelOrder := e.ApiKeyRequest("DELETE", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonCancelOrder), &errResponse); err == nil && errResponse.Code != "" {
+		return fmt.Errorf("%s CancelOrder Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
 	}
-	if err := json.Unmarshal(jsonResponse.Result, &cancelOrder); err != nil {
-		return fmt.Errorf("%s CancelOrder Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	if err := json.Unmarshal([]byte(jsonCancelOrder), &cancelOrder); err != nil {
+		return fmt.Errorf("%s CancelOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonCancelOrder)
 	}
 
 	order.Status = exchange.Canceling
@@ -399,6 +482,32 @@ func (e *Bittrex) CancelOrder(order *exchange.Order) error {
 }
 
 func (e *Bittrex) CancelAllOrder() error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	cancelAllOrder := CancelAllOrder{}
+	strRequest := "/orders/open"
+
+	jsonCancelAllOrder := e.ApiKeyRequest("DELETE", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonCancelAllOrder), &errResponse); err == nil && errResponse.Code != "" {
+		return fmt.Errorf("%s CancelAllOrder Failed: %v %v", e.GetName(), errResponse.Code, errResponse.Detail)
+	}
+	if err := json.Unmarshal([]byte(jsonCancelAllOrder), &cancelAllOrder); err != nil {
+		return fmt.Errorf("%s CancelAllOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonCancelAllOrder)
+	}
+
+	failed := []string{}
+	for _, result := range cancelAllOrder {
+		if result.StatusCode != "SUCCESS" {
+			failed = append(failed, fmt.Sprintf("%s:%s", result.ID, result.StatusCode))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%s CancelAllOrder Failed: %s", e.GetName(), strings.Join(failed, ", "))
+	}
+
 	return nil
 }
 
@@ -407,23 +516,32 @@ func (e *Bittrex) CancelAllOrder() error {
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Create mapParams Depend on API Signature request
 Step 3: Add HttpGetRequest below strUrl if API has different requests*/
-func (e *Bittrex) ApiKeyGET(strRequestPath string, mapParams map[string]string) string {
-	mapParams["apikey"] = e.API_KEY
-	mapParams["nonce"] = fmt.Sprintf("%d", time.Now().UnixNano())
+func (e *Bittrex) ApiKeyRequest(strMethod string, strRequestPath string, mapParams map[string]interface{}) string {
+	timestamp := fmt.Sprintf("%d", time.Now().UnixNano()/1e6)
+	strUrl := API_URL + strRequestPath
 
-	strUrl := API_URL + strRequestPath + "?" + exchange.Map2UrlQuery(mapParams)
+	jsonParams := ""
+	if nil != mapParams {
+		bytesParams, _ := json.Marshal(mapParams)
+		jsonParams = string(bytesParams)
+	}
 
-	signature := exchange.ComputeHmac512NoDecode(strUrl, e.API_SECRET)
-	httpClient := &http.Client{}
+	contentHash := sha512.Sum512([]byte(jsonParams))
+	strContentHash := hex.EncodeToString(contentHash[:])
+	signature := exchange.ComputeHmac512NoDecode(timestamp+strUrl+strMethod+strContentHash, e.API_SECRET)
 
-	request, err := http.NewRequest("GET", strUrl, nil)
+	request, err := http.NewRequest(strMethod, strUrl, bytes.NewBuffer([]byte(jsonParams)))
 	if nil != err {
 		return err.Error()
 	}
-	request.Header.Add("Content-Type", "application/json;charset=utf-8")
+	request.Header.Add("Content-Type", "application/json")
 	request.Header.Add("Accept", "application/json")
-	request.Header.Add("apisign", signature)
+	request.Header.Add("Api-Key", e.API_KEY)
+	request.Header.Add("Api-Timestamp", timestamp)
+	request.Header.Add("Api-Content-Hash", strContentHash)
+	request.Header.Add("Api-Signature", signature)
 
+	httpClient := &http.Client{}
 	response, err := httpClient.Do(request)
 	if nil != err {
 		return err.Error()
@@ -4,85 +4,89 @@ package bittrex
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
-import (
-	"encoding/json"
-
-	"github.com/bitontop/gored/exchange"
-)
-
-type JsonResponse struct {
-	Success bool            `json:"success"`
-	Message string          `json:"message"`
-	Result  json.RawMessage `json:"result"`
+type ErrorResponse struct {
+	Code   string      `json:"code"`
+	Detail string      `json:"detail"`
+	Data   interface{} `json:"data"`
 }
 
 type AccountBalances []struct {
-	Currency      string  `json:"Currency"`
-	Balance       float64 `json:"Balance"`
-	Available     float64 `json:"Available"`
-	Pending       float64 `json:"Pending"`
-	CryptoAddress string  `json:"CryptoAddress"`
-	Requested     bool    `json:"Requested"`
-	Uuid          string  `json:"Uuid"`
+	CurrencySymbol string `json:"currencySymbol"`
+	Total          string `json:"total"`
+	Available      string `json:"available"`
+	UpdatedAt      string `json:"updatedAt"`
 }
 
-type Uuid struct {
-	Id string `json:"uuid"`
+type PlaceOrder struct {
+	ID            string `json:"id"`
+	MarketSymbol  string `json:"marketSymbol"`
+	Direction     string `json:"direction"`
+	Type          string `json:"type"`
+	Quantity      string `json:"quantity"`
+	Limit         string `json:"limit"`
+	TimeInForce   string `json:"timeInForce"`
+	FillQuantity  string `json:"fillQuantity"`
+	Commission    string `json:"commission"`
+	Proceeds      string `json:"proceeds"`
+	ClientOrderID string `json:"clientOrderId"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"createdAt"`
+	UpdatedAt     string `json:"updatedAt"`
+	ClosedAt      string `json:"closedAt"`
 }
 
-type PlaceOrder struct {
-	AccountId                  string
-	OrderUuid                  string `json:"OrderUuid"`
-	Exchange                   string `json:"Exchange"`
-	Type                       string
-	Quantity                   float64 `json:"Quantity"`
-	QuantityRemaining          float64 `json:"QuantityRemaining"`
-	Limit                      float64 `json:"Limit"`
-	Reserved                   float64
-	ReserveRemaining           float64
-	CommissionReserved         float64
-	CommissionReserveRemaining float64
-	CommissionPaid             float64
-	Price                      float64 `json:"Price"`
-	PricePerUnit               float64 `json:"PricePerUnit"`
-	Opened                     string
-	Closed                     string
-	IsOpen                     bool
-	Sentinel                   string
-	CancelInitiated            bool
-	ImmediateOrCancel          bool
-	IsConditional              bool
-	Condition                  string
-	ConditionTarget            string
+type OpenOrders []PlaceOrder
+
+type CancelAllOrder []struct {
+	ID         string     `json:"id"`
+	StatusCode string     `json:"statusCode"`
+	Result     PlaceOrder `json:"result"`
+}
+
+type WithdrawResponse struct {
+	ID               string `json:"id"`
+	CurrencySymbol   string `json:"currencySymbol"`
+	Quantity         string `json:"quantity"`
+	CryptoAddress    string `json:"cryptoAddress"`
+	CryptoAddressTag string `json:"cryptoAddressTag"`
+	TxCost           string `json:"txCost"`
+	TxID             string `json:"txId"`
+	Status           string `json:"status"`
+	CreatedAt        string `json:"createdAt"`
 }
 
 type PairsData []struct {
-	MarketCurrency     string      `json:"MarketCurrency"`
-	BaseCurrency       string      `json:"BaseCurrency"`
-	MarketCurrencyLong string      `json:"MarketCurrencyLong"`
-	BaseCurrencyLong   string      `json:"BaseCurrencyLong"`
-	MinTradeSize       float64     `json:"MinTradeSize"`
-	MarketName         string      `json:"MarketName"`
-	IsActive           bool        `json:"IsActive"`
-	Created            string      `json:"Created"`
-	Notice             interface{} `json:"Notice"`
-	IsSponsored        interface{} `json:"IsSponsored"`
-	LogoURL            string      `json:"LogoUrl"`
+	Symbol              string   `json:"symbol"`
+	BaseCurrencySymbol  string   `json:"baseCurrencySymbol"`
+	QuoteCurrencySymbol string   `json:"quoteCurrencySymbol"`
+	MinTradeSize        string   `json:"minTradeSize"`
+	Precision           int      `json:"precision"`
+	Status              string   `json:"status"`
+	CreatedAt           string   `json:"createdAt"`
+	Notice              string   `json:"notice"`
+	ProhibitedIn        []string `json:"prohibitedIn"`
 }
 
 type CoinsData []struct {
-	Currency        string      `json:"Currency"`
-	CurrencyLong    string      `json:"CurrencyLong"`
-	MinConfirmation int         `json:"MinConfirmation"`
-	TxFee           float64     `json:"TxFee"`
-	IsActive        bool        `json:"IsActive"`
-	IsRestricted    bool        `json:"IsRestricted"`
-	CoinType        string      `json:"CoinType"`
-	BaseAddress     string      `json:"BaseAddress"`
-	Notice          interface{} `json:"Notice"`
+	Symbol           string   `json:"symbol"`
+	Name             string   `json:"name"`
+	CoinType         string   `json:"coinType"`
+	Status           string   `json:"status"`
+	MinConfirmations int      `json:"minConfirmations"`
+	Notice           string   `json:"notice"`
+	TxFee            string   `json:"txFee"`
+	LogoURL          string   `json:"logoUrl"`
+	ProhibitedIn     []string `json:"prohibitedIn"`
+	BaseAddress      string   `json:"baseAddress"`
 }
 
 type OrderBook struct {
-	Buy  []exchange.Order `json:"buy"`
-	Sell []exchange.Order `json:"sell"`
+	Bid []struct {
+		Quantity string `json:"quantity"`
+		Rate     string `json:"rate"`
+	} `json:"bid"`
+	Ask []struct {
+		Quantity string `json:"quantity"`
+		Rate     string `json:"rate"`
+	} `json:"ask"`
 }
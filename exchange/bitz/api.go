@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -70,11 +70,11 @@ func (e *Bitz) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(coinName)
+			c = exchange.GetCoin(e.GetName(), coinName)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = coinName
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), coinName)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(coinName)
@@ -123,8 +123,8 @@ func (e *Bitz) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.CoinTo)
-			target := coin.GetCoin(data.CoinFrom)
+			base := exchange.GetCoin(e.GetName(), data.CoinTo)
+			target := exchange.GetCoin(e.GetName(), data.CoinFrom)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -140,8 +140,8 @@ func (e *Bitz) GetPairsData() error {
 				ExSymbol:    data.Name,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(lotSize * -1),
-				PriceFilter: math.Pow10(priceFilter * -1),
+				LotSize:     decimal.New(1, int32(lotSize*-1)),
+				PriceFilter: decimal.New(1, int32(priceFilter*-1)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -188,11 +188,11 @@ func (e *Bitz) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
 			return nil, err
 		}
@@ -203,11 +203,11 @@ func (e *Bitz) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(orderBook.Asks[i][0], 64)
+		selldata.Rate, err = decimal.NewFromString(orderBook.Asks[i][0])
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(orderBook.Asks[i][1], 64)
+		selldata.Quantity, err = decimal.NewFromString(orderBook.Asks[i][1])
 		if err != nil {
 			return nil, err
 		}
@@ -253,14 +253,50 @@ func (e *Bitz) UpdateAllBalances() {
 			if err != nil {
 				log.Printf("%s balance Convert to float64 Error: %v %v", e.GetName(), err, v.Over)
 			} else {
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		}
 	}
 }
 
 func (e *Bitz) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-	return false
+	if e.API_KEY == "" || e.API_SECRET == "" || e.TradePassword == "" {
+		log.Printf("%s API Key, Secret Key or TradePassword are nil.", e.GetName())
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	jsonResponse := JsonResponse{}
+	withdraw := WithdrawResponse{}
+	strRequest := "/Trade/coinOut"
+
+	mapParams := make(map[string]string)
+	mapParams["coin"] = e.GetSymbolByCoin(coin)
+	mapParams["number"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["address"] = addr
+	if tag != "" {
+		mapParams["memo"] = tag
+	}
+	mapParams["tradePwd"] = e.TradePassword
+
+	jsonSubmitWithdraw := e.ApiKeyPOST(mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &jsonResponse); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if jsonResponse.Status != 200 {
+		log.Printf("%s Withdraw Failed: %v %v", e.GetName(), jsonResponse.Status, jsonResponse.Msg)
+		return false
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &withdraw); err != nil {
+		log.Printf("%s Withdraw Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+		return false
+	}
+
+	return true
 }
 
 func (e *Bitz) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -268,13 +304,18 @@ func (e *Bitz) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key, Secret Key or TradePassword are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/Trade/addEntrustSheet"
 
 	mapParams := make(map[string]string)
-	mapParams["number"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["number"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["type"] = "2"
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["tradePwd"] = e.TradePassword
@@ -293,8 +334,8 @@ func (e *Bitz) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      orderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -308,13 +349,18 @@ func (e *Bitz) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key, Secret Key or TradePassword are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/Trade/addEntrustSheet"
 
 	mapParams := make(map[string]string)
-	mapParams["number"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["number"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["type"] = "1"
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["tradePwd"] = e.TradePassword
@@ -333,8 +379,8 @@ func (e *Bitz) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      orderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -103,3 +103,9 @@ type CancelOrder struct {
 		Lock string `json:"lock"`
 	} `json:"assetsInfo"`
 }
+
+type WithdrawResponse struct {
+	ID    interface{} `json:"id"`
+	Email string      `json:"email"`
+	Coin  string      `json:"coin"`
+}
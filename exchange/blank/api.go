@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -70,15 +71,15 @@ func (e *Blank) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.AssetCode)
+			c = exchange.GetCoin(e.GetName(), data.AssetCode)
 			if c == nil {
 				c = &coin.Coin{
-					Code:     data.AssetCode,
+					Code:     exchange.GetCoinCode(e.GetName(), data.AssetCode),
 					Name:     data.AssetName,
 					Website:  data.Website,
 					Explorer: data.BlockURL,
 				}
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.AssetCode)
@@ -129,8 +130,8 @@ func (e *Blank) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(data.QuoteAsset)
-				target := coin.GetCoin(data.BaseAsset)
+				base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+				target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -144,8 +145,8 @@ func (e *Blank) GetPairsData() error {
 					ExSymbol:    data.Symbol,
 					MakerFee:    data.MakerFee,
 					TakerFee:    data.TakerFee,
-					LotSize:     data.LotSize,
-					PriceFilter: data.PriceFilter,
+					LotSize:     decimal.NewFromFloat(data.LotSize),
+					PriceFilter: decimal.NewFromFloat(data.PriceFilter),
 					Listed:      true,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -193,15 +194,15 @@ func (e *Blank) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity = bid[1]
-		buydata.Rate = bid[0]
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
+		buydata.Rate = decimal.NewFromFloat(bid[0])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity = ask[1]
-		selldata.Rate = ask[0]
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
+		selldata.Rate = decimal.NewFromFloat(ask[0])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -236,7 +237,7 @@ func (e *Blank) UpdateAllBalances() {
 	for _, balance := range accountBalance {
 		c := e.GetCoinBySymbol(balance.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Available)
+			e.balanceMap.Set(c.Code, balance.Available)
 		}
 	}
 }
@@ -248,6 +249,11 @@ func (e *Blank) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bo
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/API Path"
@@ -279,6 +285,11 @@ func (e *Blank) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -287,8 +298,8 @@ func (e *Blank) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -303,8 +314,8 @@ func (e *Blank) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -317,6 +328,11 @@ func (e *Blank) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -325,8 +341,8 @@ func (e *Blank) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -341,8 +357,8 @@ func (e *Blank) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -71,14 +71,14 @@ func (e *Bw) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Name)
+			c = exchange.GetCoin(e.GetName(), data.Name)
 			if c == nil {
 				c = &coin.Coin{
-					Code:     data.Name,
+					Code:     exchange.GetCoinCode(e.GetName(), data.Name),
 					Name:     data.Mark,
 					Explorer: data.BlockChainURL,
 				}
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Name)
@@ -145,8 +145,8 @@ func (e *Bw) GetPairsData() error {
 					ExSymbol:    data.Name,
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     math.Pow10(-1 * data.AmountDecimal),
-					PriceFilter: math.Pow10(-1 * data.PriceDecimal),
+					LotSize:     decimal.New(1, int32(-1*data.AmountDecimal)),
+					PriceFilter: decimal.New(1, int32(-1*data.PriceDecimal)),
 					Listed:      data.State == 1,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -194,15 +194,15 @@ func (e *Bw) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity, _ = strconv.ParseFloat(bid[1], 64)
-		buydata.Rate, _ = strconv.ParseFloat(bid[0], 64)
+		buydata.Quantity, _ = decimal.NewFromString(bid[1])
+		buydata.Rate, _ = decimal.NewFromString(bid[0])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for i := len(orderBook.Asks) - 1; i > 0; i-- {
 		var selldata exchange.Order
-		selldata.Quantity, _ = strconv.ParseFloat(orderBook.Asks[i][1], 64)
-		selldata.Rate, _ = strconv.ParseFloat(orderBook.Asks[i][0], 64)
+		selldata.Quantity, _ = decimal.NewFromString(orderBook.Asks[i][1])
+		selldata.Rate, _ = decimal.NewFromString(orderBook.Asks[i][0])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -237,7 +237,7 @@ func (e *Bw) UpdateAllBalances() {
 	for _, balance := range accountBalance {
 		c := e.GetCoinBySymbol(balance.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Available)
+			e.balanceMap.Set(c.Code, balance.Available)
 		}
 	}
 }
@@ -249,6 +249,11 @@ func (e *Bw) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/API Path"
@@ -280,19 +285,21 @@ func (e *Bw) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -307,8 +314,8 @@ func (e *Bw) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -321,19 +328,21 @@ func (e *Bw) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order,
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -348,8 +357,8 @@ func (e *Bw) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order,
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -19,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -68,17 +68,17 @@ func (e *Coinbene) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.QuoteAsset)
+			base = exchange.GetCoin(e.GetName(), data.QuoteAsset)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.QuoteAsset
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.QuoteAsset)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseAsset)
+			target = exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseAsset
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseAsset)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.QuoteAsset)
@@ -139,8 +139,8 @@ func (e *Coinbene) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteAsset)
-			target := coin.GetCoin(data.BaseAsset)
+			base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+			target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -170,8 +170,8 @@ func (e *Coinbene) GetPairsData() error {
 				ExSymbol:    data.Ticker,
 				MakerFee:    makerFee,
 				TakerFee:    takerFee,
-				LotSize:     math.Pow10(-1 * lotSize),
-				PriceFilter: math.Pow10(-1 * priceSize),
+				LotSize:     decimal.New(1, int32(-1*lotSize)),
+				PriceFilter: decimal.New(1, int32(-1*priceSize)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -212,16 +212,16 @@ func (e *Coinbene) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, bid := range orderBook.Orderbook.Bids {
 		var buydata exchange.Order
 
-		buydata.Rate = bid.Price
-		buydata.Quantity = bid.Quantity
+		buydata.Rate = decimal.NewFromFloat(bid.Price)
+		buydata.Quantity = decimal.NewFromFloat(bid.Quantity)
 
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Orderbook.Asks {
 		var selldata exchange.Order
 
-		selldata.Rate = ask.Price
-		selldata.Quantity = ask.Quantity
+		selldata.Rate = decimal.NewFromFloat(ask.Price)
+		selldata.Quantity = decimal.NewFromFloat(ask.Quantity)
 
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -258,7 +258,7 @@ func (e *Coinbene) UpdateAllBalances() {
 		}
 		c := e.GetCoinBySymbol(v.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
@@ -269,6 +269,11 @@ func (e *Coinbene) Withdraw(coin *coin.Coin, quantity float64, addr, tag string)
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	withdraw := Withdraw{}
 	strRequest := "/v1/withdraw/apply"
 
@@ -295,17 +300,19 @@ func (e *Coinbene) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/trade/order/place"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["type"] = "sell-limit"
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -317,8 +324,8 @@ func (e *Coinbene) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.Orderid,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -332,17 +339,19 @@ func (e *Coinbene) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/trade/order/place"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["type"] = "buy-limit"
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -354,8 +363,8 @@ func (e *Coinbene) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.Orderid,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
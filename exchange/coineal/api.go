@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"sort"
 	"strconv"
@@ -19,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -72,17 +72,17 @@ func (e *Coineal) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.CountCoin)
+			base = exchange.GetCoin(e.GetName(), data.CountCoin)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.CountCoin
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.CountCoin)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseCoin)
+			target = exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseCoin
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseCoin)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.CountCoin)
@@ -147,8 +147,8 @@ func (e *Coineal) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.CountCoin)
-			target := coin.GetCoin(data.BaseCoin)
+			base := exchange.GetCoin(e.GetName(), data.CountCoin)
+			target := exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -162,8 +162,8 @@ func (e *Coineal) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.AmountPrecision),
-				PriceFilter: math.Pow10(-1 * data.PricePrecision),
+				LotSize:     decimal.New(1, int32(-1*data.AmountPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.PricePrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -210,12 +210,12 @@ func (e *Coineal) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, bid := range orderBook.Tick.Bids {
 		var buydata exchange.Order
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0].(string), 64) //price
+		buydata.Rate, err = decimal.NewFromString(bid[0].(string)) //price
 		if err != nil {
 			return nil, err
 		}
 		//buydata.Quantity, err = strconv.ParseFloat(bid[1].(string), 64) //amount
-		buydata.Quantity = bid[1].(float64)
+		buydata.Quantity = decimal.NewFromFloat(bid[1].(float64))
 
 		maker.Bids = append(maker.Bids, buydata)
 	}
@@ -223,11 +223,11 @@ func (e *Coineal) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0].(string), 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0].(string))
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity = ask[1].(float64)
+		selldata.Quantity = decimal.NewFromFloat(ask[1].(float64))
 
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -267,13 +267,14 @@ func (e *Coineal) UpdateAllBalances() {
 
 		c := e.GetCoinBySymbol(v.Coin)
 		if c != nil {
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
 
+// Withdraw - not viable, the open API (/open/api) only has account and order endpoints, there is no withdraw endpoint
 func (e *Coineal) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -282,18 +283,20 @@ func (e *Coineal) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/open/api/create_order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["volume"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["type"] = "1"
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
@@ -309,8 +312,8 @@ func (e *Coineal) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -324,18 +327,20 @@ func (e *Coineal) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/open/api/create_order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["volume"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["type"] = "1"
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
@@ -351,8 +356,8 @@ func (e *Coineal) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -72,17 +72,17 @@ func (e *Coinex) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.PricingName)
+			base = exchange.GetCoin(e.GetName(), data.PricingName)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.PricingName
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.PricingName)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.TradingName)
+			target = exchange.GetCoin(e.GetName(), data.TradingName)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.TradingName
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.TradingName)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.PricingName)
@@ -147,8 +147,8 @@ func (e *Coinex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.PricingName)
-			target := coin.GetCoin(data.TradingName)
+			base := exchange.GetCoin(e.GetName(), data.PricingName)
+			target := exchange.GetCoin(e.GetName(), data.TradingName)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -165,8 +165,8 @@ func (e *Coinex) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    makerFee,
 				TakerFee:    takerFee,
-				LotSize:     math.Pow10(-1 * data.TradingDecimal),
-				PriceFilter: math.Pow10(-1 * data.PricingDecimal),
+				LotSize:     decimal.New(1, int32(-1*data.TradingDecimal)),
+				PriceFilter: decimal.New(1, int32(-1*data.PricingDecimal)),
 				Listed:      true,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -213,28 +213,28 @@ func (e *Coinex) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1], 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1])
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		selldata.Rate, err = strconv.ParseFloat(ask[0], 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0])
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -274,7 +274,7 @@ func (e *Coinex) UpdateAllBalances() {
 		if c != nil {
 			freeamount, err := strconv.ParseFloat(balance.Available, 64)
 			if err == nil {
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		}
 	}
@@ -287,6 +287,11 @@ func (e *Coinex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := JsonResponse{}
 	withdraw := Withdraw{}
 
@@ -325,20 +330,22 @@ func (e *Coinex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 
 	strRequest := "/v1/order/limit"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["access_id"] = e.API_KEY
 	mapParams["market"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "sell"
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -353,8 +360,8 @@ func (e *Coinex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%d", placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -367,20 +374,22 @@ func (e *Coinex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 
 	strRequest := "/v1/order/limit"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["access_id"] = e.API_KEY
 	mapParams["market"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "buy"
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -397,8 +406,8 @@ func (e *Coinex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%d", placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"sort"
@@ -19,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -76,17 +76,17 @@ func (e *Cointiger) GetCoinsData() error {
 			target := &coin.Coin{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base = coin.GetCoin(details.QuoteCurrency)
+				base = exchange.GetCoin(e.GetName(), details.QuoteCurrency)
 				if base == nil {
 					base = &coin.Coin{}
-					base.Code = details.QuoteCurrency
-					coin.AddCoin(base)
+					base.Code = exchange.GetCoinCode(e.GetName(), details.QuoteCurrency)
+					base = coin.GetOrAddCoin(base)
 				}
-				target = coin.GetCoin(details.BaseCurrency)
+				target = exchange.GetCoin(e.GetName(), details.BaseCurrency)
 				if target == nil {
 					target = &coin.Coin{}
-					target.Code = details.BaseCurrency
-					coin.AddCoin(target)
+					target.Code = exchange.GetCoinCode(e.GetName(), details.BaseCurrency)
+					target = coin.GetOrAddCoin(target)
 				}
 			case exchange.JSON_FILE:
 				base = e.GetCoinBySymbol(details.QuoteCurrency)
@@ -155,8 +155,8 @@ func (e *Cointiger) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(data.QuoteCurrency)
-				target := coin.GetCoin(data.BaseCurrency)
+				base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+				target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -165,7 +165,7 @@ func (e *Cointiger) GetPairsData() error {
 			}
 			if p != nil {
 				// ↓ orderbook precision, not trading precision.
-				priceFilter, err := strconv.ParseFloat(data.DepthSelect.Step0, 64)
+				priceFilter, err := decimal.NewFromString(data.DepthSelect.Step0)
 				if err != nil {
 					log.Printf("%s priceFilter parse error: %v, %v", e.GetBalance, err, data.DepthSelect.Step0)
 				}
@@ -175,7 +175,7 @@ func (e *Cointiger) GetPairsData() error {
 					ExSymbol:    data.BaseCurrency + data.QuoteCurrency,
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     math.Pow10(-1 * data.AmountPrecision),
+					LotSize:     decimal.New(1, int32(-1*data.AmountPrecision)),
 					PriceFilter: priceFilter, //math.Pow10(-1 * data.PricePrecision), // this is trading precision
 					Listed:      DEFAULT_LISTED,
 				}
@@ -224,11 +224,11 @@ func (e *Cointiger) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.DepthData.Tick.Buys {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(fmt.Sprintf("%v", bid[1]), 64)
+		buydata.Quantity, err = decimal.NewFromString(fmt.Sprintf("%v", bid[1]))
 		if err != nil {
 			return nil, err
 		}
-		buydata.Rate, err = strconv.ParseFloat(fmt.Sprintf("%v", bid[0]), 64)
+		buydata.Rate, err = decimal.NewFromString(fmt.Sprintf("%v", bid[0]))
 		if err != nil {
 			return nil, err
 		}
@@ -237,11 +237,11 @@ func (e *Cointiger) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 
 	for _, ask := range orderBook.DepthData.Tick.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(fmt.Sprintf("%v", ask[1]), 64)
+		selldata.Quantity, err = decimal.NewFromString(fmt.Sprintf("%v", ask[1]))
 		if err != nil {
 			return nil, err
 		}
-		selldata.Rate, err = strconv.ParseFloat(fmt.Sprintf("%v", ask[0]), 64)
+		selldata.Rate, err = decimal.NewFromString(fmt.Sprintf("%v", ask[0]))
 		if err != nil {
 			return nil, err
 		}
@@ -283,12 +283,12 @@ func (e *Cointiger) UpdateAllBalances() {
 			return
 		}
 		if c != nil {
-			balanceMap.Set(c.Code, floatBalance)
+			e.balanceMap.Set(c.Code, floatBalance)
 		}
 	}
 }
 
-/* Withdraw(coin *coin.Coin, quantity float64, addr, tag string) */
+// Withdraw - not viable, the trading API only has balance and order endpoints, there is no withdraw endpoint
 func (e *Cointiger) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
 	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
@@ -302,14 +302,19 @@ func (e *Cointiger) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchang
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/order"
 
 	mapParams := make(map[string]interface{})
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["volume"] = orderQuantity.String()
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "1"
 	mapParams["time"] = strconv.FormatInt(time.Now().UTC().UnixNano(), 10)[:13]
@@ -327,8 +332,8 @@ func (e *Cointiger) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchang
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strconv.Itoa(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -344,14 +349,19 @@ func (e *Cointiger) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/order"
 
 	mapParams := make(map[string]interface{})
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["volume"] = orderQuantity.String()
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "1"
 	mapParams["time"] = strconv.FormatInt(time.Now().UTC().UnixNano(), 10)[:13]
@@ -369,8 +379,8 @@ func (e *Cointiger) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strconv.Itoa(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
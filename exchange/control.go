@@ -0,0 +1,71 @@
+package exchange
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// NewControlHandler - POST /refresh?exchange=BINANCE&exchange=HUOBI refreshes the data of the exchanges named,
+// exNames if none is named, and answers the RefreshResult of each. The status is 200 if all succeeded,
+// 409 if any was refreshing already, 500 if any failed, and 400 without refreshing any if one named is not added.
+// There is no authentication, anyone reaching the address can trigger refreshes, so serve it on localhost
+// or behind a proxy that authenticates
+func (e *ExchangeManager) NewControlHandler(exNames []ExchangeName) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/refresh", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", "POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		names := []ExchangeName{}
+		for _, name := range r.URL.Query()["exchange"] {
+			exName := ExchangeName(strings.ToUpper(name))
+			if e.Get(exName) == nil {
+				http.Error(w, fmt.Sprintf("%s is not added", exName), http.StatusBadRequest)
+				return
+			}
+			names = append(names, exName)
+		}
+		if len(names) == 0 {
+			names = exNames
+		}
+		if len(names) == 0 {
+			http.Error(w, "no exchange to refresh", http.StatusBadRequest)
+			return
+		}
+
+		results := e.RefreshExData(names)
+		status := http.StatusOK
+		for _, result := range results {
+			if result.Success {
+				log.Printf("%s Data Refreshed in %v. Coin: %d   Pair: %d", result.ExName, result.Duration, result.Coins, result.Pairs)
+			} else {
+				log.Printf("Refreshing %s Data is failed: %s", result.ExName, result.Error)
+				if result.Refused {
+					status = http.StatusConflict
+				} else if status == http.StatusOK {
+					status = http.StatusInternalServerError
+				}
+			}
+		}
+
+		data, err := json.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(data)
+	})
+	return mux
+}
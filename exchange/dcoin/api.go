@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"sort"
@@ -20,6 +19,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -74,17 +74,17 @@ func (e *Dcoin) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.BaseCoin)
+			base = exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.BaseCoin
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.BaseCoin)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.CountCoin)
+			target = exchange.GetCoin(e.GetName(), data.CountCoin)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.CountCoin
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.CountCoin)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.BaseCoin)
@@ -150,8 +150,8 @@ func (e *Dcoin) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.BaseCoin)
-			target := coin.GetCoin(data.CountCoin)
+			base := exchange.GetCoin(e.GetName(), data.BaseCoin)
+			target := exchange.GetCoin(e.GetName(), data.CountCoin)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -165,8 +165,8 @@ func (e *Dcoin) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(1 * data.AmountPrecision),
-				PriceFilter: math.Pow10(1 * data.PricePrecision),
+				LotSize:     decimal.New(1, int32(1*data.AmountPrecision)),
+				PriceFilter: decimal.New(1, int32(1*data.PricePrecision)),
 				Listed:      true,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -214,15 +214,15 @@ func (e *Dcoin) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity = bid[1]
-		buydata.Rate = bid[0]
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
+		buydata.Rate = decimal.NewFromFloat(bid[0])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity = ask[1]
-		selldata.Rate = ask[0]
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
+		selldata.Rate = decimal.NewFromFloat(ask[0])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -258,12 +258,12 @@ func (e *Dcoin) UpdateAllBalances() {
 	for _, balance := range accountBalance.CoinList {
 		c := e.GetCoinBySymbol(balance.Coin)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Normal)
+			e.balanceMap.Set(c.Code, balance.Normal)
 		}
 	}
 }
 
-/* Withdraw(coin *coin.Coin, quantity float64, addr, tag string) */
+// Withdraw - not viable, the open API (/open/api) only has account and order endpoints, there is no withdraw endpoint
 func (e *Dcoin) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
 	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
@@ -274,6 +274,11 @@ func (e *Dcoin) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/create_order"
@@ -281,8 +286,8 @@ func (e *Dcoin) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	mapParams := make(map[string]interface{})
 	mapParams["side"] = "SELL"
 	mapParams["type"] = 1
-	mapParams["volume"] = quantity
-	mapParams["price"] = rate
+	mapParams["volume"] = json.Number(orderQuantity.String())
+	mapParams["price"] = json.Number(orderRate.String())
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
@@ -298,8 +303,8 @@ func (e *Dcoin) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strconv.Itoa(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -312,6 +317,11 @@ func (e *Dcoin) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/create_order"
@@ -319,8 +329,8 @@ func (e *Dcoin) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	mapParams := make(map[string]interface{})
 	mapParams["side"] = "BUY"
 	mapParams["type"] = 1
-	mapParams["volume"] = quantity
-	mapParams["price"] = rate
+	mapParams["volume"] = json.Number(orderQuantity.String())
+	mapParams["price"] = json.Number(orderRate.String())
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
@@ -336,8 +346,8 @@ func (e *Dcoin) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strconv.Itoa(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
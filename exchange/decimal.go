@@ -0,0 +1,28 @@
+package exchange
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// RoundStep - value rounded to a multiple of step by round, eg: 12.3 on a 0.25 tick is 12.25 with decimal.Decimal.Floor,
+// a step of 0 leaves the value as it is
+func RoundStep(value, step decimal.Decimal, round func(decimal.Decimal) decimal.Decimal) decimal.Decimal {
+	if step.Sign() <= 0 {
+		return value
+	}
+	return round(value.Div(step)).Mul(step)
+}
+
+// FormatStep - the order param of a rate or quantity, rounded to the nearest multiple of step,
+// eg: 12.3 on a 0.25 tick is "12.25", 0.30000000000000004 on a 0.1 lot size is "0.3"
+func FormatStep(value float64, step decimal.Decimal) string {
+	return RoundStep(decimal.NewFromFloat(value), step, roundNearest).String()
+}
+
+func roundNearest(value decimal.Decimal) decimal.Decimal {
+	return value.Round(0)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -68,14 +69,14 @@ func (e *Deribit) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.InstrumentName)
+			c = exchange.GetCoin(e.GetName(), data.InstrumentName)
 			if c == nil && data.InstrumentName == "BTC-27SEP19" {
 				c = &coin.Coin{
 					Code:     "CQBTC",
 					Name:     data.BaseCurrency,
 					Explorer: data.SettlementPeriod,
 				}
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.InstrumentName)
@@ -124,7 +125,7 @@ func (e *Deribit) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := &coin.Coin{000, "USD", "USD", "", "", "", 0, 0}
+				base := &coin.Coin{ID: 000, Code: "USD", Name: "USD"}
 				target := coin.GetCoin("CQBTC")
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
@@ -139,8 +140,8 @@ func (e *Deribit) GetPairsData() error {
 					ExSymbol:    data.InstrumentName,
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     data.MinTradeAmount,
-					PriceFilter: data.TickSize,
+					LotSize:     decimal.NewFromFloat(data.MinTradeAmount),
+					PriceFilter: decimal.NewFromFloat(data.TickSize),
 					Listed:      data.IsActive,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -187,15 +188,15 @@ func (e *Deribit) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity = float64(bid[1])
-		buydata.Rate = float64(bid[0])
+		buydata.Quantity = decimal.NewFromFloat(float64(bid[1]))
+		buydata.Rate = decimal.NewFromFloat(float64(bid[0]))
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity = float64(ask[1])
-		selldata.Rate = float64(ask[0])
+		selldata.Quantity = decimal.NewFromFloat(float64(ask[1]))
+		selldata.Rate = decimal.NewFromFloat(float64(ask[0]))
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -230,7 +231,7 @@ func (e *Deribit) UpdateAllBalances() {
 	for _, balance := range accountBalance {
 		c := e.GetCoinBySymbol(balance.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Available)
+			e.balanceMap.Set(c.Code, balance.Available)
 		}
 	}
 }
@@ -242,6 +243,11 @@ func (e *Deribit) Withdraw(coin *coin.Coin, quantity float64, addr, tag string)
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/API Path"
@@ -273,6 +279,11 @@ func (e *Deribit) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -281,8 +292,8 @@ func (e *Deribit) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -297,8 +308,8 @@ func (e *Deribit) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -311,6 +322,11 @@ func (e *Deribit) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -319,8 +335,8 @@ func (e *Deribit) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -335,8 +351,8 @@ func (e *Deribit) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
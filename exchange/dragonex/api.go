@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -70,11 +70,11 @@ func (e *Dragonex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Code)
+			c = exchange.GetCoin(e.GetName(), data.Code)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Code
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), data.Code)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(fmt.Sprintf("%v", data.CoinID))
@@ -124,8 +124,8 @@ func (e *Dragonex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(pairStrs[1])
-			target := coin.GetCoin(pairStrs[0])
+			base := exchange.GetCoin(e.GetName(), pairStrs[1])
+			target := exchange.GetCoin(e.GetName(), pairStrs[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -142,8 +142,8 @@ func (e *Dragonex) GetPairsData() error {
 				ExSymbol:    list[1].(string),
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * int(list[7].(float64))),
-				PriceFilter: math.Pow10(-1 * int(list[5].(float64))),
+				LotSize:     decimal.New(1, -int32(list[7].(float64))),
+				PriceFilter: decimal.New(1, -int32(list[5].(float64))),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -190,11 +190,11 @@ func (e *Dragonex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Volume, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Volume)
 		if err != nil {
 			return nil, err
 		}
@@ -205,11 +205,11 @@ func (e *Dragonex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Volume, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Volume)
 		if err != nil {
 			return nil, err
 		}
@@ -251,15 +251,16 @@ func (e *Dragonex) UpdateAllBalances() {
 		if c != nil {
 			freeamount, err := strconv.ParseFloat(balance.Volume, 64)
 			if err == nil {
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		}
 	}
 
 }
 
+// Withdraw - not viable, the v1 open API only has balance (/api/v1/user/own/) and order endpoints, there is no withdraw endpoint
 func (e *Dragonex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -268,18 +269,20 @@ func (e *Dragonex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v1/order/sell/"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]interface{})
 	symbolID, _ := strconv.Atoi(e.GetSymbolByPair(pair))
 	mapParams["symbol_id"] = symbolID
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["volume"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest, false)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -294,8 +297,8 @@ func (e *Dragonex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -309,18 +312,20 @@ func (e *Dragonex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v1/order/buy/"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]interface{})
 	symbolID, _ := strconv.Atoi(e.GetSymbolByPair(pair))
 	mapParams["symbol_id"] = symbolID
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["volume"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest, false)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -335,8 +340,8 @@ func (e *Dragonex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
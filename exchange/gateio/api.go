@@ -7,11 +7,11 @@ package gateio
 import (
 	"crypto/hmac"
 	"crypto/sha512"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -20,11 +20,14 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
+// API_URL - the base endpoint, a var so the tests can point it to a local server
+var API_URL = "https://api.gateio.ws"
+
 const (
-	API_URL     string = "https://data.gate.io"
-	Private_URL string = "https://api.gateio.io"
+	API_VERSION string = "/api/v4"
 )
 
 /*API Base Knowledge
@@ -53,74 +56,89 @@ Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
 Step 3: Modify API Path(strRequestUrl)*/
 func (e *Gateio) GetCoinsData() error {
+	errResponse := ErrorResponse{}
 	coinsData := CoinsData{}
 
-	strRequestUrl := "/api2/1/marketlist"
+	strRequestUrl := API_VERSION + "/spot/currencies"
 	strUrl := API_URL + strRequestUrl
 
 	jsonCurrencyReturn := exchange.HttpGetRequest(strUrl, nil)
+	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &errResponse); err == nil && errResponse.Label != "" {
+		return fmt.Errorf("%s Get Coins Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
 	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &coinsData); err != nil {
 		return fmt.Errorf("%s Get Coins Json Unmarshal Err: %v %v", e.GetName(), err, jsonCurrencyReturn)
-	} else if coinsData.Result != "true" {
-		return fmt.Errorf("%s Get Coins Failed: %+v", e.GetName(), coinsData)
 	}
 
-	for _, data := range coinsData.Data {
+	for _, data := range coinsData {
+		// a token is told apart by the contract on its chain
+		chain, contract := "", ""
+		for _, dataChain := range data.Chains {
+			if dataChain.Name == data.Chain && dataChain.Addr != "" {
+				chain, contract = data.Chain, dataChain.Addr
+			}
+		}
+
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Symbol)
-			if c == nil {
-				c = &coin.Coin{}
-				c.Code = data.Symbol
-				c.Name = data.Name
-				coin.AddCoin(c)
-			}
+			c = exchange.GetOrAddCoin(e.GetName(), data.Currency, &coin.Coin{
+				Chain:    chain,
+				Contract: contract,
+			})
 		case exchange.JSON_FILE:
-			c = e.GetCoinBySymbol(data.Symbol)
+			c = e.GetCoinBySymbol(data.Currency)
 		}
 
 		if c != nil {
 			coinConstraint := &exchange.CoinConstraint{
 				CoinID:       c.ID,
 				Coin:         c,
-				ExSymbol:     data.Symbol,
+				ExSymbol:     data.Currency,
 				ChainType:    exchange.MAINNET,
 				TxFee:        DEFAULT_TXFEE,
-				Withdraw:     DEFAULT_WITHDRAW,
-				Deposit:      DEFAULT_DEPOSIT,
+				Withdraw:     !data.WithdrawDisabled,
+				Deposit:      !data.DepositDisabled,
 				Confirmation: DEFAULT_CONFIRMATION,
-				Listed:       DEFAULT_LISTED,
+				Listed:       !data.Delisted,
 			}
+			coinConstraint.Contract = contract
 			e.SetCoinConstraint(coinConstraint)
 		}
 	}
 
-	return e.SetConstraint()
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil
+	}
+	// the coins are public, a key without the wallet permission keeps them with the default fees
+	if err := e.withdrawStatus(); err != nil {
+		log.Printf("%s Withdraw Status Failed, keep the default fees: %v", e.GetName(), err)
+	}
+	return nil
 }
 
-func (e *Gateio) SetConstraint() error {
-	coinsConstrain := CoinsConstrain{}
+/* withdrawStatus - the withdraw fees and minimums need the API Key */
+func (e *Gateio) withdrawStatus() error {
+	errResponse := ErrorResponse{}
+	withdrawStatus := WithdrawStatus{}
+	strRequest := "/wallet/withdraw_status"
 
-	strRequestUrl := "/api2/1/coininfo"
-	strUrl := API_URL + strRequestUrl
+	jsonWithdrawStatus := e.ApiKeyRequest("GET", strRequest, make(map[string]string))
+	if err := json.Unmarshal([]byte(jsonWithdrawStatus), &errResponse); err == nil && errResponse.Label != "" {
+		return fmt.Errorf("%s Get Withdraw Status Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
+	if err := json.Unmarshal([]byte(jsonWithdrawStatus), &withdrawStatus); err != nil {
+		return fmt.Errorf("%s Get Withdraw Status Json Unmarshal Err: %v %v", e.GetName(), err, jsonWithdrawStatus)
+	}
 
-	jsonCurrencyReturn := exchange.HttpGetRequest(strUrl, nil)
-	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &coinsConstrain); err != nil {
-		return fmt.Errorf("%s Get Coins' Constraint Json Unmarshal Err: %v %v", e.GetName(), err, jsonCurrencyReturn)
-	} else if coinsConstrain.Result != "true" {
-		return fmt.Errorf("%s Get Coins' Constraint Failed: %+v", e.GetName(), coinsConstrain)
-	}
-
-	for _, coins := range coinsConstrain.Coins {
-		for symbol, data := range coins {
-			c := e.GetCoinBySymbol(symbol)
-			if c != nil {
-				constrain := e.GetCoinConstraint(c)
-				constrain.Deposit = data.DepositDisabled == 0
-				constrain.Withdraw = data.WithdrawDisabled == 0
-				constrain.Listed = data.Delisted == 0
-			}
+	for _, data := range withdrawStatus {
+		c := e.GetCoinBySymbol(data.Currency)
+		if c == nil {
+			continue
+		}
+		if coinConstraint := e.GetCoinConstraint(c); coinConstraint != nil {
+			coinConstraint.TxFee, _ = strconv.ParseFloat(data.WithdrawFix, 64)
+			coinConstraint.MinWithdraw, _ = strconv.ParseFloat(data.WithdrawAmountMini, 64)
 		}
 	}
 	return nil
@@ -131,49 +149,50 @@ Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
 Step 3: Modify API Path(strRequestUrl)*/
 func (e *Gateio) GetPairsData() error {
+	errResponse := ErrorResponse{}
 	pairsData := PairsData{}
 
-	strRequestUrl := "/api2/1/marketinfo"
+	strRequestUrl := API_VERSION + "/spot/currency_pairs"
 	strUrl := API_URL + strRequestUrl
 
 	jsonSymbolsReturn := exchange.HttpGetRequest(strUrl, nil)
+	if err := json.Unmarshal([]byte(jsonSymbolsReturn), &errResponse); err == nil && errResponse.Label != "" {
+		return fmt.Errorf("%s Get Pairs Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
 	if err := json.Unmarshal([]byte(jsonSymbolsReturn), &pairsData); err != nil {
 		return fmt.Errorf("%s Get Pairs Json Unmarshal Err: %v %v", e.GetName(), err, jsonSymbolsReturn)
-	} else if pairsData.Result != "true" {
-		return fmt.Errorf("%s Get Pairs Failed: %+v", e.GetName(), pairsData)
 	}
 
-	for _, pairs := range pairsData.Pairs {
-		for symbol, data := range pairs {
-			p := &pair.Pair{}
-			switch e.Source {
-			case exchange.EXCHANGE_API:
-				pairSymbol := strings.Split(symbol, "_")
-				base := coin.GetCoin(pairSymbol[1])
-				target := coin.GetCoin(pairSymbol[0])
-				if base != nil && target != nil {
-
-					p = pair.GetPair(base, target)
-
-				}
-			case exchange.JSON_FILE:
-				p = e.GetPairBySymbol(symbol)
+	for _, data := range pairsData {
+		p := &pair.Pair{}
+		switch e.Source {
+		case exchange.EXCHANGE_API:
+			base := exchange.GetCoin(e.GetName(), data.Quote)
+			target := exchange.GetCoin(e.GetName(), data.Base)
+			if base != nil && target != nil {
+				p = pair.GetPair(base, target)
 			}
+		case exchange.JSON_FILE:
+			p = e.GetPairBySymbol(data.ID)
+		}
 
-			priceFilter := math.Pow10(data.DecimalPlaces * -1)
-			if p != nil {
-				pairConstraint := &exchange.PairConstraint{
-					PairID:      p.ID,
-					Pair:        p,
-					ExSymbol:    symbol,
-					MakerFee:    data.Fee / 100,
-					TakerFee:    data.Fee / 100,
-					LotSize:     DEFAULT_LOT_SIZE,
-					PriceFilter: priceFilter,
-					Listed:      DEFAULT_LISTED,
-				}
-				e.SetPairConstraint(pairConstraint)
+		if p != nil {
+			fee, _ := strconv.ParseFloat(data.Fee, 64)
+			minNotional, _ := decimal.NewFromString(data.MinQuoteAmount)
+			minAmount, _ := decimal.NewFromString(data.MinBaseAmount)
+			pairConstraint := &exchange.PairConstraint{
+				PairID:      p.ID,
+				Pair:        p,
+				ExSymbol:    data.ID,
+				MakerFee:    fee / 100,
+				TakerFee:    fee / 100,
+				LotSize:     decimal.New(1, int32(data.AmountPrecision*-1)),
+				PriceFilter: decimal.New(1, int32(data.Precision*-1)),
+				MinNotional: minNotional,
+				MinQuantity: minAmount,
+				Listed:      data.TradeStatus == "tradable",
 			}
+			e.SetPairConstraint(pairConstraint)
 		}
 	}
 	return nil
@@ -187,21 +206,27 @@ Step 4: Modify API Path(strRequestUrl)
 Step 5: Add Params - Depend on API request
 Step 6: Convert the response to Standard Maker struct*/
 func (e *Gateio) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
+	errResponse := ErrorResponse{}
 	orderBook := OrderBook{}
 	symbol := e.GetSymbolByPair(pair)
 
-	strRequestUrl := fmt.Sprintf("/api2/1/orderBook/%s", symbol)
+	mapParams := make(map[string]string)
+	mapParams["currency_pair"] = symbol
+	mapParams["limit"] = "100"
+
+	strRequestUrl := API_VERSION + "/spot/order_book"
 	strUrl := API_URL + strRequestUrl
 
 	maker := &exchange.Maker{}
 	maker.WorkerIP = exchange.GetExternalIP()
 	maker.BeforeTimestamp = float64(time.Now().UnixNano() / 1e6)
 
-	jsonOrderbook := exchange.HttpGetRequest(strUrl, nil)
+	jsonOrderbook := exchange.HttpGetRequest(strUrl, mapParams)
+	if err := json.Unmarshal([]byte(jsonOrderbook), &errResponse); err == nil && errResponse.Label != "" {
+		return nil, fmt.Errorf("%s Get Orderbook Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
 	if err := json.Unmarshal([]byte(jsonOrderbook), &orderBook); err != nil {
 		return nil, fmt.Errorf("%s Get Orderbook Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderbook)
-	} else if orderBook.Result != "true" {
-		return nil, fmt.Errorf("%s Get Orderbook Failed: %+v", e.GetName(), orderBook)
 	}
 
 	maker.AfterTimestamp = float64(time.Now().UnixNano() / 1e6)
@@ -210,13 +235,13 @@ func (e *Gateio) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
-			return nil, fmt.Errorf("GateIo Bids Rate ParseFloat error:%v", err)
+			return nil, fmt.Errorf("GateIo Bids Rate NewFromString error:%v", err)
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
-			return nil, fmt.Errorf("GateIo Bids Quantity ParseFloat error:%v", err)
+			return nil, fmt.Errorf("GateIo Bids Quantity NewFromString error:%v", err)
 		}
 
 		maker.Bids = append(maker.Bids, buydata)
@@ -225,13 +250,13 @@ func (e *Gateio) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0], 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0])
 		if err != nil {
-			return nil, fmt.Errorf("GateIo Asks Rate ParseFloat error:%v", err)
+			return nil, fmt.Errorf("GateIo Asks Rate NewFromString error:%v", err)
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1], 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1])
 		if err != nil {
-			return nil, fmt.Errorf("GateIo Asks Quantity ParseFloat error:%v", err)
+			return nil, fmt.Errorf("GateIo Asks Quantity NewFromString error:%v", err)
 		}
 
 		maker.Asks = append(maker.Asks, selldata)
@@ -246,43 +271,70 @@ func (e *Gateio) UpdateAllBalances() {
 		return
 	}
 
+	errResponse := ErrorResponse{}
 	accountBalance := AccountBalances{}
-	freeBalance := make(map[string]string)
-	strRequest := "/api2/1/private/balances"
+	strRequest := "/spot/accounts"
 
-	jsonBalanceReturn := e.ApiKeyPost(strRequest, make(map[string]string))
-	if err := json.Unmarshal([]byte(jsonBalanceReturn), &accountBalance); err != nil {
-		log.Printf("%s UpdateAllBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
-		return
-	} else if accountBalance.Result != "true" {
-		log.Printf("%s UpdateAllBalances Failed: %+v", e.GetName(), accountBalance)
+	jsonBalanceReturn := e.ApiKeyRequest("GET", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &errResponse); err == nil && errResponse.Label != "" {
+		log.Printf("%s UpdateAllBalances Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
 		return
 	}
-	if string(accountBalance.Available) != "[]" {
-		if err := json.Unmarshal(accountBalance.Available, &freeBalance); err != nil {
-			log.Printf("%s UpdateAllBalances Data Unmarshal Err: %v %v", e.GetName(), err, accountBalance.Available)
-			return
-		}
-	} else {
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &accountBalance); err != nil {
+		log.Printf("%s UpdateAllBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
 		return
 	}
 
-	for key, data := range freeBalance {
-		freeAmount, err := strconv.ParseFloat(data, 64)
+	for _, data := range accountBalance {
+		freeAmount, err := strconv.ParseFloat(data.Available, 64)
 		if err != nil {
 			log.Printf("%s freeAmount parse Failed: %v", e.GetName(), data)
 			return
 		}
-		c := e.GetCoinBySymbol(key)
+		c := e.GetCoinBySymbol(data.Currency)
 		if c != nil {
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
 
 func (e *Gateio) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
 
-	return false
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	errResponse := ErrorResponse{}
+	withdraw := WithdrawResponse{}
+	strRequest := "/wallet/withdrawals"
+
+	mapParams := make(map[string]string)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["address"] = addr
+	if tag != "" {
+		mapParams["memo"] = tag
+	}
+
+	jsonSubmitWithdraw := e.ApiKeyRequest("POST", strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &errResponse); err == nil && errResponse.Label != "" {
+		log.Printf("%s Withdraw Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+		return false
+	}
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if withdraw.ID == "" {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonSubmitWithdraw)
+		return false
+	}
+
+	return true
 }
 
 func (e *Gateio) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -290,27 +342,21 @@ func (e *Gateio) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	placeOrder := PlaceOrder{}
-	strRequest := "/api2/1/private/sell"
-
-	mapParams := make(map[string]string)
-	mapParams["currencyPair"] = e.GetSymbolByPair(pair)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["orderType"] = "" //ioc: immediate order cancel
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 
-	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Json Unmarshal Err: %v %v", e.GetName(), err, jsonPlaceReturn)
-	} else if placeOrder.Result != "true" {
-		return nil, fmt.Errorf("%s LimitSell Failed: %+v", e.GetName(), placeOrder)
+	placeOrder, jsonPlaceReturn, err := e.placeOrder(pair, "sell", orderQuantity, orderRate)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitSell %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      fmt.Sprintf("%d", placeOrder.OrderNumber),
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      placeOrder.ID,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -324,27 +370,21 @@ func (e *Gateio) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	placeOrder := PlaceOrder{}
-	strRequest := "/api2/1/private/buy"
-
-	mapParams := make(map[string]string)
-	mapParams["currencyPair"] = e.GetSymbolByPair(pair)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["orderType"] = "" //ioc: immediate order cancel
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 
-	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
-		return nil, fmt.Errorf("%s LimitBuy Json Unmarshal Err: %v %v", e.GetName(), err, jsonPlaceReturn)
-	} else if placeOrder.Result != "true" {
-		return nil, fmt.Errorf("%s LimitBuy Failed: %+v", e.GetName(), placeOrder)
+	placeOrder, jsonPlaceReturn, err := e.placeOrder(pair, "buy", orderQuantity, orderRate)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitBuy %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      fmt.Sprintf("%d", placeOrder.OrderNumber),
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      placeOrder.ID,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -353,43 +393,122 @@ func (e *Gateio) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	return order, nil
 }
 
+func (e *Gateio) placeOrder(pair *pair.Pair, side string, quantity, rate decimal.Decimal) (*PlaceOrder, string, error) {
+	errResponse := ErrorResponse{}
+	placeOrder := &PlaceOrder{}
+	strRequest := "/spot/orders"
+
+	mapParams := make(map[string]string)
+	mapParams["currency_pair"] = e.GetSymbolByPair(pair)
+	mapParams["type"] = "limit"
+	mapParams["account"] = "spot"
+	mapParams["side"] = side
+	mapParams["amount"] = quantity.String()
+	mapParams["price"] = rate.String()
+	mapParams["time_in_force"] = "gtc"
+
+	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &errResponse); err == nil && errResponse.Label != "" {
+		return nil, jsonPlaceReturn, fmt.Errorf("Failed: %v %v", errResponse.Label, errResponse.Message)
+	}
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), placeOrder); err != nil {
+		return nil, jsonPlaceReturn, fmt.Errorf("Json Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	}
+
+	return placeOrder, jsonPlaceReturn, nil
+}
+
 func (e *Gateio) OrderStatus(order *exchange.Order) error {
 	if e.API_KEY == "" || e.API_SECRET == "" {
 		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	orderStatus := OrderStatus{}
-	strRequest := "/api2/1/private/getOrder"
+	errResponse := ErrorResponse{}
+	orderStatus := PlaceOrder{}
+	strRequest := fmt.Sprintf("/spot/orders/%s", order.OrderID)
 
 	mapParams := make(map[string]string)
-	mapParams["orderNumber"] = order.OrderID
-	mapParams["currencyPair"] = e.GetSymbolByPair(order.Pair)
+	mapParams["currency_pair"] = e.GetSymbolByPair(order.Pair)
 
-	jsonOrderStatus := e.ApiKeyPost(strRequest, mapParams)
+	jsonOrderStatus := e.ApiKeyRequest("GET", strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonOrderStatus), &errResponse); err == nil && errResponse.Label != "" {
+		return fmt.Errorf("%s OrderStatus Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
 	if err := json.Unmarshal([]byte(jsonOrderStatus), &orderStatus); err != nil {
 		return fmt.Errorf("%s OrderStatus Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderStatus)
-	} else if orderStatus.Result != "true" {
-		return fmt.Errorf("%s OrderStatus Failed: %+v", e.GetName(), orderStatus)
 	}
 
 	order.StatusMessage = jsonOrderStatus
-	if orderStatus.Order.Status == "cancelled" {
+	setOrderStatus(order, &orderStatus)
+
+	return nil
+}
+
+func setOrderStatus(order *exchange.Order, orderStatus *PlaceOrder) {
+	amount, _ := strconv.ParseFloat(orderStatus.Amount, 64)
+	left, _ := strconv.ParseFloat(orderStatus.Left, 64)
+	filledTotal, _ := strconv.ParseFloat(orderStatus.FilledTotal, 64)
+	dealQuantity := amount - left
+
+	if orderStatus.Status == "cancelled" {
 		order.Status = exchange.Canceled
-	} else if orderStatus.Order.Status == "filled" {
+	} else if orderStatus.Status == "closed" {
 		order.Status = exchange.Filled
-	} else if orderStatus.Order.Status == "partial-filled" || orderStatus.Order.Status == "partial-canceled" {
+	} else if orderStatus.Status == "open" && dealQuantity > 0 {
 		order.Status = exchange.Partial
-	} else if orderStatus.Order.Status == "submitting" || orderStatus.Order.Status == "submitted" {
+	} else if orderStatus.Status == "open" {
 		order.Status = exchange.New
 	} else {
 		order.Status = exchange.Other
 	}
 
-	return nil
+	order.DealQuantity = dealQuantity
+	if dealQuantity > 0 {
+		order.DealRate = filledTotal / dealQuantity
+	}
 }
 
 func (e *Gateio) ListOrders() ([]*exchange.Order, error) {
-	return nil, nil
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	openOrders := OpenOrders{}
+	strRequest := "/spot/open_orders"
+
+	jsonOpenOrders := e.ApiKeyRequest("GET", strRequest, nil)
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &errResponse); err == nil && errResponse.Label != "" {
+		return nil, fmt.Errorf("%s ListOrders Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &openOrders); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Json Unmarshal Err: %v %v", e.GetName(), err, jsonOpenOrders)
+	}
+
+	orders := []*exchange.Order{}
+	for _, pairOrders := range openOrders {
+		p := e.GetPairBySymbol(pairOrders.CurrencyPair)
+		for i := range pairOrders.Orders {
+			data := &pairOrders.Orders[i]
+			rate, _ := strconv.ParseFloat(data.Price, 64)
+			quantity, _ := strconv.ParseFloat(data.Amount, 64)
+			order := &exchange.Order{
+				Pair:     p,
+				OrderID:  data.ID,
+				Rate:     decimal.NewFromFloat(rate),
+				Quantity: decimal.NewFromFloat(quantity),
+			}
+			if data.Side == "buy" {
+				order.Side = "Buy"
+			} else {
+				order.Side = "Sell"
+			}
+			setOrderStatus(order, data)
+			orders = append(orders, order)
+		}
+	}
+
+	return orders, nil
 }
 
 func (e *Gateio) CancelOrder(order *exchange.Order) error {
@@ -397,18 +516,19 @@ func (e *Gateio) CancelOrder(order *exchange.Order) error {
 		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	cancelOrder := CancelOrder{}
-	strRequest := "/api2/1/private/cancelOrder"
+	errResponse := ErrorResponse{}
+	cancelOrder := PlaceOrder{}
+	strRequest := fmt.Sprintf("/spot/orders/%s", order.OrderID)
 
 	mapParams := make(map[string]string)
-	mapParams["orderNumber"] = order.OrderID
-	mapParams["currencyPair"] = e.GetSymbolByPair(order.Pair)
+	mapParams["currency_pair"] = e.GetSymbolByPair(order.Pair)
 
-	jsonCancelOrder := e.ApiKeyPost(strRequest, mapParams)
+	jsonCancelOrder := e.ApiKeyRequest("DELETE", strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonCancelOrder), &errResponse); err == nil && errResponse.Label != "" {
+		return fmt.Errorf("%s CancelOrder Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
 	if err := json.Unmarshal([]byte(jsonCancelOrder), &cancelOrder); err != nil {
 		return fmt.Errorf("%s CancelOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonCancelOrder)
-	} else if !cancelOrder.Result {
-		return fmt.Errorf("%s CancelOrder Failed: %v", e.GetName(), cancelOrder.Message)
 	}
 
 	order.Status = exchange.Canceling
@@ -417,7 +537,37 @@ func (e *Gateio) CancelOrder(order *exchange.Order) error {
 	return nil
 }
 
+// CancelAllOrder cancels the open orders pair by pair, v4 requires currency_pair on the batch cancel
 func (e *Gateio) CancelAllOrder() error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	openOrders := OpenOrders{}
+
+	jsonOpenOrders := e.ApiKeyRequest("GET", "/spot/open_orders", nil)
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &errResponse); err == nil && errResponse.Label != "" {
+		return fmt.Errorf("%s CancelAllOrder Failed: %v %v", e.GetName(), errResponse.Label, errResponse.Message)
+	}
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &openOrders); err != nil {
+		return fmt.Errorf("%s CancelAllOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonOpenOrders)
+	}
+
+	for _, pairOrders := range openOrders {
+		cancelAllOrder := CancelAllOrder{}
+		mapParams := make(map[string]string)
+		mapParams["currency_pair"] = pairOrders.CurrencyPair
+
+		jsonCancelAllOrder := e.ApiKeyRequest("DELETE", "/spot/orders", mapParams)
+		if err := json.Unmarshal([]byte(jsonCancelAllOrder), &errResponse); err == nil && errResponse.Label != "" {
+			return fmt.Errorf("%s CancelAllOrder %s Failed: %v %v", e.GetName(), pairOrders.CurrencyPair, errResponse.Label, errResponse.Message)
+		}
+		if err := json.Unmarshal([]byte(jsonCancelAllOrder), &cancelAllOrder); err != nil {
+			return fmt.Errorf("%s CancelAllOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonCancelAllOrder)
+		}
+	}
+
 	return nil
 }
 
@@ -426,13 +576,27 @@ func (e *Gateio) CancelAllOrder() error {
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Create mapParams Depend on API Signature request
 Step 3: Add HttpGetRequest below strUrl if API has different requests*/
-func (e *Gateio) ApiKeyPost(strRequestPath string, mapParams map[string]string) string {
-	strMethod := "POST"
+func (e *Gateio) ApiKeyRequest(strMethod string, strRequestPath string, mapParams map[string]string) string {
+	timestamp := fmt.Sprintf("%d", time.Now().Unix())
+	strRequestPath = API_VERSION + strRequestPath
+
+	query := ""
+	payload := ""
+	if strMethod == "POST" {
+		bytesParams, _ := json.Marshal(mapParams)
+		payload = string(bytesParams)
+	} else {
+		query = exchange.Map2UrlQueryUrl(mapParams)
+	}
 
-	payload := exchange.Map2UrlQuery(mapParams)
-	Signature := ComputeHmac512(payload, e.API_SECRET)
+	strUrl := API_URL + strRequestPath
+	if query != "" {
+		strUrl += "?" + query
+	}
 
-	strUrl := Private_URL + strRequestPath
+	hashedPayload := sha512.Sum512([]byte(payload))
+	strSign := strings.Join([]string{strMethod, strRequestPath, query, hex.EncodeToString(hashedPayload[:]), timestamp}, "\n")
+	signature := ComputeHmac512(strSign, e.API_SECRET)
 
 	httpClient := &http.Client{}
 
@@ -441,9 +605,11 @@ func (e *Gateio) ApiKeyPost(strRequestPath string, mapParams map[string]string)
 		return err.Error()
 	}
 
-	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	request.Header.Set("key", e.API_KEY)
-	request.Header.Set("sign", Signature)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("KEY", e.API_KEY)
+	request.Header.Set("Timestamp", timestamp)
+	request.Header.Set("SIGN", signature)
 
 	response, err := httpClient.Do(request)
 	if nil != err {
@@ -1,138 +1,100 @@
 package gateio
 
-import "encoding/json"
-
 // Copyright (c) 2015-2019 Bitontop Technologies Inc.
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
-type CoinsData struct {
-	Result string `json:"result"`
-	Data   []struct {
-		No          int         `json:"no"`
-		Symbol      string      `json:"symbol"`
-		Name        string      `json:"name"`
-		NameEn      string      `json:"name_en"`
-		NameCn      string      `json:"name_cn"`
-		Pair        string      `json:"pair"`
-		Rate        string      `json:"rate"`
-		VolA        string      `json:"vol_a"`
-		VolB        string      `json:"vol_b"`
-		CurrA       string      `json:"curr_a"`
-		CurrB       string      `json:"curr_b"`
-		CurrSuffix  string      `json:"curr_suffix"`
-		RatePercent string      `json:"rate_percent"`
-		Trend       string      `json:"trend"`
-		Supply      int         `json:"supply"`
-		Marketcap   interface{} `json:"marketcap"`
-		Lq          string      `json:"lq"`
-	} `json:"data"`
-}
-
-type CoinsConstrain struct {
-	Result string                  `json:"result"`
-	Coins  []map[string]*Constrain `json:"coins"`
+type ErrorResponse struct {
+	Label   string `json:"label"`
+	Message string `json:"message"`
 }
 
-type Constrain struct {
-	Delisted         int `json:"delisted"`
-	WithdrawDisabled int `json:"withdraw_disabled"`
-	WithdrawDelayed  int `json:"withdraw_delayed"`
-	DepositDisabled  int `json:"deposit_disabled"`
-	TradeDisabled    int `json:"trade_disabled"`
+type CoinsData []struct {
+	Currency         string `json:"currency"`
+	Delisted         bool   `json:"delisted"`
+	WithdrawDisabled bool   `json:"withdraw_disabled"`
+	WithdrawDelayed  bool   `json:"withdraw_delayed"`
+	DepositDisabled  bool   `json:"deposit_disabled"`
+	TradeDisabled    bool   `json:"trade_disabled"`
+	Chain            string `json:"chain"`
+	Chains           []struct {
+		Name string `json:"name"`
+		Addr string `json:"addr"`
+	} `json:"chains"`
 }
 
-type PairsData struct {
-	Result string             `json:"result"`
-	Pairs  []map[string]*Pair `json:"pairs"`
+type WithdrawStatus []struct {
+	Currency           string `json:"currency"`
+	WithdrawFix        string `json:"withdraw_fix"`
+	WithdrawAmountMini string `json:"withdraw_amount_mini"`
 }
 
-type Pair struct {
-	DecimalPlaces int     `json:"decimal_places"`
-	MinAmount     float64 `json:"min_amount"`
-	MinAmountA    float64 `json:"min_amount_a"`
-	MinAmountB    float64 `json:"min_amount_b"`
-	Fee           float64 `json:"fee"`
-	TradeDisabled int     `json:"trade_disabled"`
+type PairsData []struct {
+	ID              string `json:"id"`
+	Base            string `json:"base"`
+	Quote           string `json:"quote"`
+	Fee             string `json:"fee"`
+	MinBaseAmount   string `json:"min_base_amount"`
+	MinQuoteAmount  string `json:"min_quote_amount"`
+	AmountPrecision int    `json:"amount_precision"`
+	Precision       int    `json:"precision"`
+	TradeStatus     string `json:"trade_status"`
 }
 
 type OrderBook struct {
-	Elapsed string     `json:"elapsed"`
+	ID      int64      `json:"id"`
+	Current int64      `json:"current"`
+	Update  int64      `json:"update"`
 	Asks    [][]string `json:"asks"`
 	Bids    [][]string `json:"bids"`
-	Result  string     `json:"result"`
 }
 
-type AccountBalances struct {
-	Result    string          `json:"result"`
-	Available json.RawMessage `json:"available"`
-	Locked    json.RawMessage `json:"locked"`
-	Code      int             `json:"code"`
-	Message   string          `json:"message"`
-}
-
-type FreeBalance struct {
-	Result    string            `json:"result"`
-	Available map[string]string `json:"available"`
-	Locked    map[string]string `json:"locked"`
-	Code      int               `json:"code"`
-	Message   string            `json:"message"`
+type AccountBalances []struct {
+	Currency  string `json:"currency"`
+	Available string `json:"available"`
+	Locked    string `json:"locked"`
 }
 
 type PlaceOrder struct {
-	Result        string  `json:"result"`
-	Message       string  `json:"message"`
-	Code          int     `json:"code"`
-	Ctime         float64 `json:"ctime"`
-	Side          int     `json:"side"`
-	OrderNumber   int     `json:"orderNumber"`
-	Rate          string  `json:"rate"`
-	LeftAmount    string  `json:"leftAmount"`
-	DealStock     string  `json:"deal_stock"`
-	DealMoney     string  `json:"deal_money"`
-	FilledAmount  string  `json:"filledAmount"`
-	FilledRate    string  `json:"filledRate"`
-	FeePercentage float64 `json:"feePercentage"`
-	FeeValue      string  `json:"feeValue"`
-	FeeCurrency   string  `json:"feeCurrency"`
-	Fee           string  `json:"fee"`
+	ID                 string `json:"id"`
+	Text               string `json:"text"`
+	CreateTime         string `json:"create_time"`
+	UpdateTime         string `json:"update_time"`
+	Status             string `json:"status"`
+	CurrencyPair       string `json:"currency_pair"`
+	Type               string `json:"type"`
+	Account            string `json:"account"`
+	Side               string `json:"side"`
+	Amount             string `json:"amount"`
+	Price              string `json:"price"`
+	TimeInForce        string `json:"time_in_force"`
+	Left               string `json:"left"`
+	FilledTotal        string `json:"filled_total"`
+	FillPrice          string `json:"fill_price"`
+	Fee                string `json:"fee"`
+	FeeCurrency        string `json:"fee_currency"`
+	PointFee           string `json:"point_fee"`
+	GtFee              string `json:"gt_fee"`
+	GtDiscount         bool   `json:"gt_discount"`
+	RebatedFee         string `json:"rebated_fee"`
+	RebatedFeeCurrency string `json:"rebated_fee_currency"`
 }
 
-type OrderStatus struct {
-	Result string `json:"result"`
-	Order  struct {
-		ID            string `json:"id"`
-		Status        string `json:"status"`
-		Pair          string `json:"pair"`
-		Type          string `json:"type"`
-		Rate          string `json:"rate"`
-		Amount        string `json:"amount"`
-		InitialRate   string `json:"initial_rate"`
-		InitialAmount string `json:"initial_amount"`
-	} `json:"order"`
-	Message string `json:"message"`
+type OpenOrders []struct {
+	CurrencyPair string       `json:"currency_pair"`
+	Total        int          `json:"total"`
+	Orders       []PlaceOrder `json:"orders"`
 }
 
-type CancelOrder struct {
-	Result bool `json:"result"`
-	Order  struct {
-		OrderNumber   string  `json:"orderNumber"`
-		Status        string  `json:"status"`
-		CurrencyPair  string  `json:"currencyPair"`
-		Type          string  `json:"type"`
-		Rate          string  `json:"rate"`
-		Amount        string  `json:"amount"`
-		InitialRate   string  `json:"initialRate"`
-		InitialAmount string  `json:"initialAmount"`
-		FilledAmount  string  `json:"filledAmount"`
-		FilledRate    string  `json:"filledRate"`
-		FeePercentage float64 `json:"feePercentage"`
-		FeeValue      string  `json:"feeValue"`
-		FeeCurrency   string  `json:"feeCurrency"`
-		Fee           string  `json:"fee"`
-		Timestamp     int     `json:"timestamp"`
-	} `json:"order"`
-	Message string `json:"message"`
-	Code    int    `json:"code"`
-	Elapsed string `json:"elapsed"`
+type CancelAllOrder []PlaceOrder
+
+type WithdrawResponse struct {
+	ID        string `json:"id"`
+	Timestamp string `json:"timestamp"`
+	Currency  string `json:"currency"`
+	Address   string `json:"address"`
+	Txid      string `json:"txid"`
+	Amount    string `json:"amount"`
+	Memo      string `json:"memo"`
+	Status    string `json:"status"`
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 
 	"strconv"
 )
@@ -70,17 +71,17 @@ func (e *Gemini) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.Base)
+			base = exchange.GetCoin(e.GetName(), data.Base)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.Base
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.Base)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.Quote)
+			target = exchange.GetCoin(e.GetName(), data.Quote)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.Quote
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.Quote)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.Base)
@@ -140,8 +141,8 @@ func (e *Gemini) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.Base)
-			target := coin.GetCoin(data.Quote)
+			base := exchange.GetCoin(e.GetName(), data.Base)
+			target := exchange.GetCoin(e.GetName(), data.Quote)
 			if base != nil && target != nil {
 
 				p = pair.GetPair(base, target)
@@ -157,8 +158,8 @@ func (e *Gemini) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     data.MinOrderIncre,
-				PriceFilter: data.MinPriceIncre,
+				LotSize:     decimal.NewFromFloat(data.MinOrderIncre),
+				PriceFilter: decimal.NewFromFloat(data.MinPriceIncre),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -201,27 +202,27 @@ func (e *Gemini) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Amount, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Amount)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Amount, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Amount)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -254,7 +255,7 @@ func (e *Gemini) UpdateAllBalances() {
 				log.Printf("%s balance parse Err: %v %v", e.GetName(), err, v.Amount)
 				return
 			}
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
@@ -268,6 +269,11 @@ func (e *Gemini) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		log.Printf("%s API Key or Secret Key are nil", e.GetName())
 		return false
 	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
 	withdrawal := Withdrawal{}
 	strRequest := "/v1/withdraw" + "/" + strings.ToLower(coin.Code)
 
@@ -288,14 +294,19 @@ func (e *Gemini) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	if e.API_KEY == "" || e.API_SECRET == "" {
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
+
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 	sellorder := PlaceOrder{}
 	strRequest := "/v1/order/new"
 
 	mapParams := make(map[string]interface{})
 	mapParams["request"] = strRequest
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["side"] = "sell"
 	mapParams["type"] = "exchange limit"
 
@@ -307,8 +318,8 @@ func (e *Gemini) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      sellorder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -321,14 +332,19 @@ func (e *Gemini) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	if e.API_KEY == "" || e.API_SECRET == "" {
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
+
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 	buyorder := PlaceOrder{}
 	strRequest := "/v1/order/new"
 
 	mapParams := make(map[string]interface{})
 	mapParams["request"] = strRequest
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["side"] = "buy"
 	mapParams["type"] = "exchange limit"
 
@@ -340,8 +356,8 @@ func (e *Gemini) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      buyorder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
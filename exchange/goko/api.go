@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"time"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -72,17 +72,17 @@ func (e *Goko) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.CountCoin)
+			base = exchange.GetCoin(e.GetName(), data.CountCoin)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.CountCoin
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.CountCoin)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseCoin)
+			target = exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseCoin
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseCoin)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.CountCoin)
@@ -149,8 +149,8 @@ func (e *Goko) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.CountCoin)
-			target := coin.GetCoin(data.BaseCoin)
+			base := exchange.GetCoin(e.GetName(), data.CountCoin)
+			target := exchange.GetCoin(e.GetName(), data.BaseCoin)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -164,8 +164,8 @@ func (e *Goko) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.AmountPrecision),
-				PriceFilter: math.Pow10(-1 * data.PricePrecision),
+				LotSize:     decimal.New(1, int32(-1*data.AmountPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.PricePrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -212,15 +212,15 @@ func (e *Goko) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Tick.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity = bid[1]
-		buydata.Rate = bid[0]
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
+		buydata.Rate = decimal.NewFromFloat(bid[0])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Tick.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity = ask[1]
-		selldata.Rate = ask[0]
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
+		selldata.Rate = decimal.NewFromFloat(ask[0])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -255,7 +255,7 @@ func (e *Goko) UpdateAllBalances() {
 	for _, balance := range accountBalance {
 		c := e.GetCoinBySymbol(balance.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Available)
+			e.balanceMap.Set(c.Code, balance.Available)
 		}
 	}
 }
@@ -267,6 +267,11 @@ func (e *Goko) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) boo
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/API Path"
@@ -298,6 +303,11 @@ func (e *Goko) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -306,8 +316,8 @@ func (e *Goko) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -322,8 +332,8 @@ func (e *Goko) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -336,6 +346,11 @@ func (e *Goko) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -344,8 +359,8 @@ func (e *Goko) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -360,8 +375,8 @@ func (e *Goko) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
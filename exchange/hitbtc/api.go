@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -63,12 +64,12 @@ func (e *Hitbtc) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.ID)
+			c = exchange.GetCoin(e.GetName(), data.ID)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.ID
+				c.Code = exchange.GetCoinCode(e.GetName(), data.ID)
 				c.Name = data.FullName
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.ID)
@@ -112,8 +113,8 @@ func (e *Hitbtc) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteCurrency)
-			target := coin.GetCoin(data.BaseCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -121,11 +122,11 @@ func (e *Hitbtc) GetPairsData() error {
 			p = e.GetPairBySymbol(data.ID)
 		}
 		if p != nil {
-			lotSize, err := strconv.ParseFloat(data.QuantityIncrement, 64)
+			lotSize, err := decimal.NewFromString(data.QuantityIncrement)
 			if err != nil {
 				log.Printf("%s Lot Size Err: %s", e.GetName(), err)
 			}
-			priceFilter, err := strconv.ParseFloat(data.TickSize, 64)
+			priceFilter, err := decimal.NewFromString(data.TickSize)
 			if err != nil {
 				log.Printf("%s Price Filter Err: %s", e.GetName(), err)
 			}
@@ -181,13 +182,13 @@ func (e *Hitbtc) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, bid := range orderBook.Bid {
 		var buydata exchange.Order
 
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s Bids Rate ParseFloat error: %v", e.GetName(), err)
+			return nil, fmt.Errorf("%s Bids Rate NewFromString error: %v", e.GetName(), err)
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Size, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Size)
 		if err != nil {
-			return nil, fmt.Errorf("%s Bids Quantity ParseFloat error: %v", e.GetName(), err)
+			return nil, fmt.Errorf("%s Bids Quantity NewFromString error: %v", e.GetName(), err)
 		}
 
 		maker.Bids = append(maker.Bids, buydata)
@@ -195,13 +196,13 @@ func (e *Hitbtc) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, ask := range orderBook.Ask {
 		var selldata exchange.Order
 
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s Asks Rate ParseFloat error: %v", e.GetName(), err)
+			return nil, fmt.Errorf("%s Asks Rate NewFromString error: %v", e.GetName(), err)
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Size, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Size)
 		if err != nil {
-			return nil, fmt.Errorf("%s Asks Quantity ParseFloat error: %v", e.GetName(), err)
+			return nil, fmt.Errorf("%s Asks Quantity NewFromString error: %v", e.GetName(), err)
 		}
 
 		maker.Asks = append(maker.Asks, selldata)
@@ -235,7 +236,7 @@ func (e *Hitbtc) UpdateAllBalances() {
 		if err == nil {
 			c := e.GetCoinBySymbol(v.Currency)
 			if c != nil {
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		} else {
 			log.Printf("%s %s Get Balance Err: %s\n", e.GetName(), v.Currency, err)
@@ -244,14 +245,84 @@ func (e *Hitbtc) UpdateAllBalances() {
 }
 
 func (e *Hitbtc) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	// withdrawals are paid from the main account, move the funds out of the trading account first
+	if err := e.transfer(coin, quantity, "exchangeToBank"); err != nil {
+		log.Printf("%s Withdraw Transfer Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	errResponse := ErrResponse{}
+	withdraw := WithdrawResponse{}
+	strRequest := "/api/2/account/crypto/withdraw"
+
+	mapParams := make(map[string]string)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["address"] = addr
+	if tag != "" {
+		mapParams["paymentId"] = tag
+	}
+
+	jsonSubmitWithdraw := e.ApiKeyRequest("POST", mapParams, strRequest)
+	json.Unmarshal([]byte(jsonSubmitWithdraw), &errResponse)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+	} else if errResponse.Error.Code != 0 {
+		log.Printf("%s Withdraw Failed: %v %v", e.GetName(), errResponse.Error.Code, errResponse.Error.Message)
+	} else if withdraw.ID == "" {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonSubmitWithdraw)
+	} else {
+		return true
+	}
+
+	// the funds can't be traded in the main account, move them back
+	if err := e.transfer(coin, quantity, "bankToExchange"); err != nil {
+		log.Printf("%s Withdraw Transfer Back Failed, %v %s left in the main account: %v", e.GetName(), quantity, coin.Code, err)
+	}
 	return false
 }
 
+// transfer - moves funds between the main account (bank) and the trading account (exchange), transferType is exchangeToBank or bankToExchange
+func (e *Hitbtc) transfer(coin *coin.Coin, quantity float64, transferType string) error {
+	errResponse := ErrResponse{}
+	transfer := TransferResponse{}
+	strRequest := "/api/2/account/transfer"
+
+	mapParams := make(map[string]string)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["type"] = transferType
+
+	jsonTransfer := e.ApiKeyRequest("POST", mapParams, strRequest)
+	json.Unmarshal([]byte(jsonTransfer), &errResponse)
+	if err := json.Unmarshal([]byte(jsonTransfer), &transfer); err != nil {
+		return fmt.Errorf("%s Transfer Json Unmarshal Err: %v %v", e.GetName(), err, jsonTransfer)
+	} else if errResponse.Error.Code != 0 {
+		return fmt.Errorf("%s Transfer Failed: %v %v", e.GetName(), errResponse.Error.Code, errResponse.Error.Message)
+	}
+	return nil
+}
+
 func (e *Hitbtc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
 	if e.API_KEY == "" || e.API_SECRET == "" {
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	errResponse := ErrResponse{}
 	strRequest := "/api/2/order"
@@ -260,8 +331,8 @@ func (e *Hitbtc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "sell"
 	mapParams["type"] = "limit"
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["quantity"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	json.Unmarshal([]byte(jsonPlaceReturn), &errResponse)
@@ -274,8 +345,8 @@ func (e *Hitbtc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.ID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -289,6 +360,11 @@ func (e *Hitbtc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	errResponse := ErrResponse{}
 	strRequest := "/api/2/order"
@@ -297,8 +373,8 @@ func (e *Hitbtc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "buy"
 	mapParams["type"] = "limit"
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["quantity"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	json.Unmarshal([]byte(jsonPlaceReturn), &errResponse)
@@ -311,8 +387,8 @@ func (e *Hitbtc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.ID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -353,7 +429,7 @@ func (e *Hitbtc) OrderStatus(order *exchange.Order) error {
 			order.Status = exchange.Other
 		}
 
-		order.DealRate = order.Rate
+		order.DealRate = order.Rate.InexactFloat64()
 		order.DealQuantity, _ = strconv.ParseFloat(orderStatus.CumQuantity, 64)
 	}
 
@@ -410,6 +486,7 @@ func (e *Hitbtc) ApiKeyRequest(strMethod string, mapParams map[string]string, st
 	}
 	request.Header.Add("User-Agent", "Mozilla/5.0 (Windows NT 6.1; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/39.0.2171.71 Safari/537.36")
 	request.Header.Add("Accept", "application/json")
+	request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	request.SetBasicAuth(e.API_KEY, e.API_SECRET)
 
 	httpClient := &http.Client{}
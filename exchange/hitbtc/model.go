@@ -71,3 +71,11 @@ type PlaceOrder struct {
 	UpdatedAt     time.Time `json:"updatedAt"`
 	PostOnly      bool      `json:"postOnly"`
 }
+
+type TransferResponse struct {
+	ID string `json:"id"`
+}
+
+type WithdrawResponse struct {
+	ID string `json:"id"`
+}
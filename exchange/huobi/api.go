@@ -20,6 +20,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -52,11 +53,93 @@ Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
 Step 3: Modify API Path(strRequestUrl)*/
 func (e *Huobi) GetCoinsData() error {
-	jsonResponse := &JsonResponse{}
 	coinsData := CoinsData{}
 
-	//strRequestUrl := "/v1/common/currencys"
-	strUrl := "https://www.huobi.com/-/x/pro/v1/settings/currencys?r=sqyeinryv8&language=en-US"
+	strRequestUrl := "/v2/reference/currencies"
+	strUrl := API_URL + strRequestUrl
+
+	jsonCurrencyReturn := exchange.HttpGetRequest(strUrl, nil)
+	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &coinsData); err != nil {
+		log.Printf("%s Get Coins Json Unmarshal Err, fall back to public data: %v %v", e.GetName(), err, jsonCurrencyReturn)
+		return e.getPublicCoinsData()
+	} else if coinsData.Code != 200 {
+		log.Printf("%s Get Coins Failed, fall back to public data: %v %v", e.GetName(), coinsData.Code, coinsData.Message)
+		return e.getPublicCoinsData()
+	}
+
+	for _, data := range coinsData.Data {
+		c := &coin.Coin{}
+		symbol := strings.ToUpper(data.Currency)
+		switch e.Source {
+		case exchange.EXCHANGE_API:
+			// the API has the chain a token is on but not the contract
+			chain := ""
+			if defaultChain := getDefaultChain(data.Currency, data.Chains); defaultChain != nil && !strings.EqualFold(defaultChain.BaseChain, symbol) {
+				chain = strings.ToUpper(defaultChain.BaseChain)
+			}
+			c = exchange.GetOrAddCoin(e.GetName(), symbol, &coin.Coin{
+				Chain: chain,
+			})
+		case exchange.JSON_FILE:
+			c = e.GetCoinBySymbol(data.Currency)
+		}
+
+		if c != nil {
+			coinConstraint := &exchange.CoinConstraint{
+				CoinID:       c.ID,
+				Coin:         c,
+				ExSymbol:     data.Currency,
+				ChainType:    exchange.MAINNET,
+				TxFee:        DEFAULT_TXFEE,
+				Withdraw:     false,
+				Deposit:      false,
+				Confirmation: DEFAULT_CONFIRMATION,
+				Listed:       data.InstStatus == "normal",
+			}
+
+			if chain := getDefaultChain(data.Currency, data.Chains); chain != nil {
+				if chain.BaseChainProtocol != "" {
+					coinConstraint.ChainType = exchange.ChainType(chain.BaseChainProtocol)
+				}
+				if chain.WithdrawFeeType == "fixed" {
+					coinConstraint.TxFee, _ = strconv.ParseFloat(chain.TransactFeeWithdraw, 64)
+				} else {
+					coinConstraint.TxFee, _ = strconv.ParseFloat(chain.MinTransactFeeWithdraw, 64)
+				}
+				coinConstraint.MinWithdraw, _ = strconv.ParseFloat(chain.MinWithdrawAmt, 64)
+				coinConstraint.WithdrawStep = math.Pow10(-chain.WithdrawPrecision)
+				coinConstraint.MinDeposit, _ = strconv.ParseFloat(chain.MinDepositAmt, 64)
+				coinConstraint.Withdraw = chain.WithdrawStatus == "allowed"
+				coinConstraint.Deposit = chain.DepositStatus == "allowed"
+				coinConstraint.Confirmation = chain.NumOfConfirmations
+			}
+
+			e.SetCoinConstraint(coinConstraint)
+		}
+	}
+	return nil
+}
+
+/* getDefaultChain - the chain named after the currency is the default one, otherwise the first listed */
+func getDefaultChain(currency string, chains []ChainData) *ChainData {
+	for i := range chains {
+		if chains[i].Chain == currency {
+			return &chains[i]
+		}
+	}
+	if len(chains) > 0 {
+		return &chains[0]
+	}
+	return nil
+}
+
+/* getPublicCoinsData - the currency list without chain details, constraints use the default values */
+func (e *Huobi) getPublicCoinsData() error {
+	jsonResponse := &JsonResponse{}
+	coinsData := PublicCoinsData{}
+
+	strRequestUrl := "/v1/common/currencys"
+	strUrl := API_URL + strRequestUrl
 
 	jsonCurrencyReturn := exchange.HttpGetRequest(strUrl, nil)
 	if err := json.Unmarshal([]byte(jsonCurrencyReturn), &jsonResponse); err != nil {
@@ -68,30 +151,31 @@ func (e *Huobi) GetCoinsData() error {
 		return fmt.Errorf("%s Get Coins Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
 	}
 
-	for _, data := range coinsData {
+	for _, currency := range coinsData {
 		c := &coin.Coin{}
+		symbol := strings.ToUpper(currency)
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.DisplayName)
+			c = exchange.GetCoin(e.GetName(), symbol)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.DisplayName
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), symbol)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
-			c = e.GetCoinBySymbol(data.DisplayName)
+			c = e.GetCoinBySymbol(currency)
 		}
 
 		if c != nil {
 			coinConstraint := &exchange.CoinConstraint{
 				CoinID:       c.ID,
 				Coin:         c,
-				ExSymbol:     data.Name,
+				ExSymbol:     currency,
 				ChainType:    exchange.MAINNET,
 				TxFee:        DEFAULT_TXFEE,
-				Withdraw:     data.WithdrawEnabled,
-				Deposit:      data.DepositEnabled,
-				Confirmation: data.SafeConfirms,
+				Withdraw:     DEFAULT_WITHDRAW,
+				Deposit:      DEFAULT_DEPOSIT,
+				Confirmation: DEFAULT_CONFIRMATION,
 				Listed:       true,
 			}
 			e.SetCoinConstraint(coinConstraint)
@@ -125,8 +209,8 @@ func (e *Huobi) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(strings.ToUpper(data.QuoteCurrency))
-			target := coin.GetCoin(strings.ToUpper(data.BaseCurrency))
+			base := exchange.GetCoin(e.GetName(), strings.ToUpper(data.QuoteCurrency))
+			target := exchange.GetCoin(e.GetName(), strings.ToUpper(data.BaseCurrency))
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -140,9 +224,16 @@ func (e *Huobi) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(data.AmountPrecision * -1),
-				PriceFilter: math.Pow10(data.PricePrecision * -1),
+				LotSize:     decimal.New(1, int32(data.AmountPrecision*-1)),
+				PriceFilter: decimal.New(1, int32(data.PricePrecision*-1)),
+				MinNotional: data.MinOrderValue,
+				MinQuantity: data.MinOrderAmt,
+				MaxQuantity: data.MaxOrderAmt,
 				Listed:      true,
+
+				// buy market orders are counted in the base coin, only the sell limits are quantities
+				MarketMinQuantity: data.SellMarketMinOrderAmt,
+				MarketMaxQuantity: data.SellMarketMaxOrderAmt,
 			}
 			e.SetPairConstraint(pairConstraint)
 		}
@@ -187,16 +278,16 @@ func (e *Huobi) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, bid := range orderBook.Bids {
 		var buydata exchange.Order
 
-		buydata.Rate = bid[0]
-		buydata.Quantity = bid[1]
+		buydata.Rate = decimal.NewFromFloat(bid[0])
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
 
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Asks {
 		var selldata exchange.Order
 
-		selldata.Rate = ask[0]
-		selldata.Quantity = ask[1]
+		selldata.Rate = decimal.NewFromFloat(ask[0])
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
 
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -268,7 +359,7 @@ func (e *Huobi) UpdateAllBalances() {
 			if err == nil {
 				c := e.GetCoinBySymbol(v.Currency)
 				if c != nil {
-					balanceMap.Set(c.Code, freeamount)
+					e.balanceMap.Set(c.Code, freeamount)
 				}
 			} else {
 				log.Printf("%s %s Get Balance Err: %s\n", e.GetName(), v.Currency, err)
@@ -283,6 +374,11 @@ func (e *Huobi) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bo
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	var withdrawID int64
 	strRequest := "/v1/dw/withdraw/api/create"
@@ -316,6 +412,11 @@ func (e *Huobi) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	if e.Account_ID == "" {
 		e.Account_ID = e.GetAccounts()
 		if e.Account_ID == "" {
@@ -327,13 +428,10 @@ func (e *Huobi) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	placeOrder := ""
 	strRequest := "/v1/order/orders/place"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["account-id"] = e.Account_ID
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "sell-limit"
 
@@ -350,8 +448,8 @@ func (e *Huobi) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -365,6 +463,11 @@ func (e *Huobi) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	if e.Account_ID == "" {
 		e.Account_ID = e.GetAccounts()
 		if e.Account_ID == "" {
@@ -376,13 +479,10 @@ func (e *Huobi) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	placeOrder := ""
 	strRequest := "/v1/order/orders/place"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["account-id"] = e.Account_ID
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "buy-limit"
 
@@ -399,8 +499,8 @@ func (e *Huobi) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -494,6 +594,404 @@ func (e *Huobi) CancelAllOrder() error {
 	return nil
 }
 
+/*************** Margin API ***************/
+/* Huobi cross margin uses the "super-margin" account, the pair is only used by the margin orders */
+func (e *Huobi) GetAccountByType(accountType string) string {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil", e.GetName())
+		return ""
+	}
+
+	jsonResponse := &JsonResponse{}
+	accountsReturn := AccountsReturn{}
+
+	strRequest := "/v1/account/accounts"
+
+	jsonAccountsReturn := e.ApiKeyRequest("GET", make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonAccountsReturn), &jsonResponse); err != nil {
+		log.Printf("%s Get AccountID Json Unmarshal Err: %v %v", e.GetName(), err, jsonAccountsReturn)
+		return ""
+	} else if jsonResponse.Status != "ok" {
+		log.Printf("%s Get AccountID Failed: %v", e.GetName(), jsonResponse)
+		return ""
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &accountsReturn); err != nil {
+		log.Printf("%s Get AccountID Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+		return ""
+	}
+
+	for _, account := range accountsReturn {
+		if account.Type == accountType {
+			return strconv.FormatInt(account.ID, 10)
+		}
+	}
+	log.Printf("%s Get AccountID Failed: no %s account", e.GetName(), accountType)
+	return ""
+}
+
+func (e *Huobi) getMarginAccount() (*MarginAccountBalance, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	marginAccount := &MarginAccountBalance{}
+	strRequest := "/v1/cross-margin/accounts/balance"
+
+	jsonBalanceReturn := e.ApiKeyRequest("GET", make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &jsonResponse); err != nil {
+		return nil, fmt.Errorf("%s Get Margin Account Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
+	} else if jsonResponse.Status != "ok" {
+		return nil, fmt.Errorf("%s Get Margin Account Failed: %v", e.GetName(), jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, marginAccount); err != nil {
+		return nil, fmt.Errorf("%s Get Margin Account Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+	}
+
+	return marginAccount, nil
+}
+
+func (e *Huobi) GetMarginBalances(pair *pair.Pair) ([]*exchange.MarginBalance, error) {
+	marginAccount, err := e.getMarginAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	currencyBalances := make(map[string]*exchange.MarginBalance)
+	balances := []*exchange.MarginBalance{}
+	for _, v := range marginAccount.List {
+		c := e.GetCoinBySymbol(v.Currency)
+		if c == nil {
+			continue
+		}
+		balance, ok := currencyBalances[v.Currency]
+		if !ok {
+			balance = &exchange.MarginBalance{Coin: c}
+			currencyBalances[v.Currency] = balance
+			balances = append(balances, balance)
+		}
+
+		// loan and interest are reported as negative balances
+		amount, _ := strconv.ParseFloat(v.Balance, 64)
+		switch v.Type {
+		case "trade":
+			balance.Free = amount
+		case "frozen":
+			balance.Locked = amount
+		case "loan":
+			balance.Borrowed = math.Abs(amount)
+		case "interest":
+			balance.Interest = math.Abs(amount)
+		}
+	}
+
+	return balances, nil
+}
+
+/* GetMarginLevel - the risk rate of the cross margin account */
+func (e *Huobi) GetMarginLevel(pair *pair.Pair) (float64, error) {
+	marginAccount, err := e.getMarginAccount()
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(marginAccount.RiskRate, 64)
+}
+
+func (e *Huobi) GetMaxBorrowable(pair *pair.Pair, coin *coin.Coin) (float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return 0, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	loanInfo := MarginLoanInfo{}
+	strRequest := "/v1/cross-margin/loan-info"
+
+	jsonLoanReturn := e.ApiKeyRequest("GET", make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonLoanReturn), &jsonResponse); err != nil {
+		return 0, fmt.Errorf("%s GetMaxBorrowable Json Unmarshal Err: %v %v", e.GetName(), err, jsonLoanReturn)
+	} else if jsonResponse.Status != "ok" {
+		return 0, fmt.Errorf("%s GetMaxBorrowable Failed: %v", e.GetName(), jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &loanInfo); err != nil {
+		return 0, fmt.Errorf("%s GetMaxBorrowable Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+	}
+
+	symbol := e.GetSymbolByCoin(coin)
+	for _, info := range loanInfo {
+		if info.Currency == symbol {
+			return strconv.ParseFloat(info.LoanableAmt, 64)
+		}
+	}
+	return 0, fmt.Errorf("%s GetMaxBorrowable: %s can't be borrowed", e.GetName(), symbol)
+}
+
+func (e *Huobi) Borrow(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	var loanID int64
+	strRequest := "/v1/cross-margin/orders"
+
+	mapParams := make(map[string]string)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonBorrowReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonBorrowReturn), &jsonResponse); err != nil {
+		return fmt.Errorf("%s Borrow Json Unmarshal Err: %v %v", e.GetName(), err, jsonBorrowReturn)
+	} else if jsonResponse.Status != "ok" {
+		return fmt.Errorf("%s Borrow Failed: %v", e.GetName(), jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &loanID); err != nil {
+		return fmt.Errorf("%s Borrow Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+	}
+
+	return nil
+}
+
+func (e *Huobi) Repay(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	if e.Margin_Account_ID == "" {
+		e.Margin_Account_ID = e.GetAccountByType("super-margin")
+		if e.Margin_Account_ID == "" {
+			return fmt.Errorf("%s Get Margin AccountID Err", e.GetName())
+		}
+	}
+
+	repayment := RepaymentResponse{}
+	strRequest := "/v2/account/repayment"
+
+	mapParams := make(map[string]string)
+	mapParams["accountId"] = e.Margin_Account_ID
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonRepayReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonRepayReturn), &repayment); err != nil {
+		return fmt.Errorf("%s Repay Json Unmarshal Err: %v %v", e.GetName(), err, jsonRepayReturn)
+	} else if repayment.Code != 200 {
+		return fmt.Errorf("%s Repay Failed: %v %v", e.GetName(), repayment.Code, repayment.Message)
+	}
+
+	return nil
+}
+
+func (e *Huobi) MarginLimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "sell-limit", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitSell %v", e.GetName(), err)
+	}
+	order.Side = "Sell"
+	return order, nil
+}
+
+func (e *Huobi) MarginLimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "buy-limit", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitBuy %v", e.GetName(), err)
+	}
+	order.Side = "Buy"
+	return order, nil
+}
+
+func (e *Huobi) placeMarginOrder(pair *pair.Pair, orderType string, quantity, rate float64) (*exchange.Order, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("API Key or Secret Key are nil")
+	}
+
+	if e.Margin_Account_ID == "" {
+		e.Margin_Account_ID = e.GetAccountByType("super-margin")
+		if e.Margin_Account_ID == "" {
+			return nil, fmt.Errorf("Get Margin AccountID Err")
+		}
+	}
+
+	jsonResponse := &JsonResponse{}
+	placeOrder := ""
+	strRequest := "/v1/order/orders/place"
+
+	mapParams := make(map[string]string)
+	mapParams["account-id"] = e.Margin_Account_ID
+	mapParams["amount"] = exchange.FormatStep(quantity, e.GetLotSize(pair))
+	mapParams["price"] = exchange.FormatStep(rate, e.GetPriceFilter(pair))
+	mapParams["symbol"] = e.GetSymbolByPair(pair)
+	mapParams["type"] = orderType
+	mapParams["source"] = "super-margin-api"
+
+	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
+		return nil, fmt.Errorf("Json Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	} else if jsonResponse.Status != "ok" {
+		return nil, fmt.Errorf("Failed: %v", jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &placeOrder); err != nil {
+		return nil, fmt.Errorf("Data Unmarshal Err: %v %s", err, jsonResponse.Data)
+	}
+
+	order := &exchange.Order{
+		Pair:         pair,
+		OrderID:      placeOrder,
+		Rate:         decimal.NewFromFloat(rate),
+		Quantity:     decimal.NewFromFloat(quantity),
+		Status:       exchange.New,
+		JsonResponse: jsonPlaceReturn,
+	}
+	return order, nil
+}
+
+/*************** Transfer API ***************/
+func (e *Huobi) GetAccountTypes() []exchange.AccountType {
+	return []exchange.AccountType{exchange.SPOT, exchange.MARGIN, exchange.FUTURES}
+}
+
+/* InternalTransfer - the margin and futures accounts only transfer with the spot account */
+func (e *Huobi) InternalTransfer(coin *coin.Coin, quantity float64, from, to exchange.AccountType) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	var transferID int64
+	strRequest := ""
+
+	mapParams := make(map[string]string)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	switch {
+	case from == exchange.SPOT && to == exchange.MARGIN:
+		strRequest = "/v1/cross-margin/transfer-in"
+	case from == exchange.MARGIN && to == exchange.SPOT:
+		strRequest = "/v1/cross-margin/transfer-out"
+	case from == exchange.SPOT && to == exchange.FUTURES, from == exchange.FUTURES && to == exchange.SPOT:
+		strRequest = "/v1/futures/transfer"
+		mapParams["type"] = fmt.Sprintf("%s-to-%s", ACCOUNT_TYPE[from], ACCOUNT_TYPE[to])
+	default:
+		return fmt.Errorf("%s InternalTransfer from %s to %s is not supported", e.GetName(), from, to)
+	}
+
+	jsonTransferReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransferReturn), &jsonResponse); err != nil {
+		return fmt.Errorf("%s InternalTransfer Json Unmarshal Err: %v %v", e.GetName(), err, jsonTransferReturn)
+	} else if jsonResponse.Status != "ok" {
+		return fmt.Errorf("%s InternalTransfer Failed: %v", e.GetName(), jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &transferID); err != nil {
+		return fmt.Errorf("%s InternalTransfer Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+	}
+
+	return nil
+}
+
+/*************** Sub Account API ***************/
+/* Sub-users are identified by their uid, the transfers only move between the spot accounts */
+func (e *Huobi) GetSubAccounts() ([]*exchange.SubAccount, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	subUserList := SubUserList{}
+	strRequest := "/v2/sub-user/user-list"
+
+	jsonSubUserReturn := e.ApiKeyRequest("GET", make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonSubUserReturn), &subUserList); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccounts Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubUserReturn)
+	} else if subUserList.Code != 200 {
+		return nil, fmt.Errorf("%s GetSubAccounts Failed: %v %v", e.GetName(), subUserList.Code, subUserList.Message)
+	}
+
+	subAccounts := []*exchange.SubAccount{}
+	for _, subUser := range subUserList.Data {
+		subAccounts = append(subAccounts, &exchange.SubAccount{
+			ID:     strconv.FormatInt(subUser.UID, 10),
+			Frozen: subUser.UserState != "normal",
+		})
+	}
+	return subAccounts, nil
+}
+
+func (e *Huobi) GetSubAccountBalances(sub *exchange.SubAccount) (map[string]float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	subUserBalances := []AccountBalances{}
+	strRequest := fmt.Sprintf("/v1/account/accounts/%s", sub.ID)
+
+	jsonBalanceReturn := e.ApiKeyRequest("GET", make(map[string]string), strRequest)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &jsonResponse); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccountBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
+	} else if jsonResponse.Status != "ok" {
+		return nil, fmt.Errorf("%s GetSubAccountBalances Failed: %v", e.GetName(), jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &subUserBalances); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccountBalances Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+	}
+
+	balances := make(map[string]float64)
+	for _, account := range subUserBalances {
+		if account.Type != "spot" {
+			continue
+		}
+		for _, v := range account.List {
+			if v.Type != "trade" {
+				continue
+			}
+			c := e.GetCoinBySymbol(v.Currency)
+			if c == nil {
+				continue
+			}
+			if freeamount, err := strconv.ParseFloat(v.Balance, 64); err == nil {
+				balances[c.Code] = freeamount
+			}
+		}
+	}
+	return balances, nil
+}
+
+func (e *Huobi) TransferToSubAccount(sub *exchange.SubAccount, coin *coin.Coin, quantity float64) error {
+	return e.subUserTransfer(sub, coin, quantity, "master-transfer-out")
+}
+
+func (e *Huobi) TransferFromSubAccount(sub *exchange.SubAccount, coin *coin.Coin, quantity float64) error {
+	return e.subUserTransfer(sub, coin, quantity, "master-transfer-in")
+}
+
+func (e *Huobi) subUserTransfer(sub *exchange.SubAccount, coin *coin.Coin, quantity float64, transferType string) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	var transferID int64
+	strRequest := "/v1/subuser/transfer"
+
+	mapParams := make(map[string]string)
+	mapParams["sub-uid"] = sub.ID
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["type"] = transferType
+
+	jsonTransferReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransferReturn), &jsonResponse); err != nil {
+		return fmt.Errorf("%s SubUser Transfer Json Unmarshal Err: %v %v", e.GetName(), err, jsonTransferReturn)
+	} else if jsonResponse.Status != "ok" {
+		return fmt.Errorf("%s SubUser Transfer Failed: %v", e.GetName(), jsonResponse)
+	}
+	if err := json.Unmarshal(jsonResponse.Data, &transferID); err != nil {
+		return fmt.Errorf("%s SubUser Transfer Data Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+	}
+
+	return nil
+}
+
 /*************** Signature Http Request ***************/
 /*Method: API Request and Signature is required
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
@@ -4,6 +4,8 @@ package huobi
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
+import "github.com/bitontop/gored/exchange"
+
 const (
 	DEFAULT_ID           = 11
 	DEFAULT_TAKER_FEE    = 0.002
@@ -11,4 +13,14 @@ const (
 	DEFAULT_LOT_SIZE     = 0.00000001
 	DEFAULT_PRICE_FILTER = 0.00000001
 	DEFAULT_TXFEE        = 0.005
+	DEFAULT_WITHDRAW     = true
+	DEFAULT_DEPOSIT      = true
+	DEFAULT_CONFIRMATION = 2
 )
+
+/* Huobi account types, MARGIN is the cross margin account */
+var ACCOUNT_TYPE = map[exchange.AccountType]string{
+	exchange.SPOT:    "pro",
+	exchange.MARGIN:  "super-margin",
+	exchange.FUTURES: "futures",
+}
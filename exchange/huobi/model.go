@@ -6,6 +6,8 @@ package huobi
 
 import (
 	"encoding/json"
+
+	"github.com/shopspring/decimal"
 )
 
 type JsonResponse struct {
@@ -16,41 +18,40 @@ type JsonResponse struct {
 	ErrMsg  string          `json:"err-msg"`
 }
 
-type CoinsData []struct {
-	CurrencyAddrWithTag     bool          `json:"currency-addr-with-tag"`
-	FastConfirms            int           `json:"fast-confirms"`
-	SafeConfirms            int           `json:"safe-confirms"`
-	CurrencyType            string        `json:"currency-type"`
-	SupportSites            []interface{} `json:"support-sites"`
-	OtcEnable               int           `json:"otc-enable"`
-	CountryDisabled         bool          `json:"country-disabled"`
-	Tags                    interface{}   `json:"tags"`
-	DepositEnabled          bool          `json:"deposit-enabled"`
-	WithdrawEnabled         bool          `json:"withdraw-enabled"`
-	WhiteEnabled            bool          `json:"white-enabled"`
-	WithdrawPrecision       int           `json:"withdraw-precision"`
-	CurrencyPartition       string        `json:"currency-partition"`
-	QuoteCurrency           bool          `json:"quote-currency"`
-	WithdrawMinAmount       string        `json:"withdraw-min-amount"`
-	Weight                  int           `json:"weight"`
-	Visible                 bool          `json:"visible"`
-	ShowPrecision           string        `json:"show-precision"`
-	DepositMinAmount        string        `json:"deposit-min-amount"`
-	VisibleAssetsTimestamp  int64         `json:"visible-assets-timestamp"`
-	DepositEnableTimestamp  int64         `json:"deposit-enable-timestamp"`
-	WithdrawEnableTimestamp int64         `json:"withdraw-enable-timestamp"`
-	Name                    string        `json:"name"`
-	State                   string        `json:"state"`
-	DisplayName             string        `json:"display-name"`
-	DepositDesc             string        `json:"deposit-desc"`
-	WithdrawDesc            string        `json:"withdraw-desc"`
-	SuspendVisibleDesc      string        `json:"suspend-visible-desc"`
-	SuspendDepositDesc      string        `json:"suspend-deposit-desc"`
-	SuspendWithdrawDesc     string        `json:"suspend-withdraw-desc"`
-	CurrencyAddrOneoff      bool          `json:"currency-addr-oneoff,omitempty"`
-	Blockchains             string        `json:"blockchains,omitempty"`
+type CoinsData struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    []struct {
+		Currency   string      `json:"currency"`
+		AssetType  int         `json:"assetType"`
+		InstStatus string      `json:"instStatus"`
+		Chains     []ChainData `json:"chains"`
+	} `json:"data"`
+}
+
+type ChainData struct {
+	Chain                  string `json:"chain"`
+	DisplayName            string `json:"displayName"`
+	BaseChain              string `json:"baseChain"`
+	BaseChainProtocol      string `json:"baseChainProtocol"`
+	IsDynamic              bool   `json:"isDynamic"`
+	NumOfConfirmations     int    `json:"numOfConfirmations"`
+	NumOfFastConfirmations int    `json:"numOfFastConfirmations"`
+	DepositStatus          string `json:"depositStatus"`
+	MinDepositAmt          string `json:"minDepositAmt"`
+	WithdrawStatus         string `json:"withdrawStatus"`
+	MinWithdrawAmt         string `json:"minWithdrawAmt"`
+	WithdrawPrecision      int    `json:"withdrawPrecision"`
+	MaxWithdrawAmt         string `json:"maxWithdrawAmt"`
+	WithdrawFeeType        string `json:"withdrawFeeType"`
+	TransactFeeWithdraw    string `json:"transactFeeWithdraw"`
+	MinTransactFeeWithdraw string `json:"minTransactFeeWithdraw"`
+	AddrWithTag            bool   `json:"addrWithTag"`
+	AddrDepositTag         bool   `json:"addrDepositTag"`
 }
 
+type PublicCoinsData []string
+
 type PairsData []struct {
 	BaseCurrency    string `json:"base-currency"`
 	QuoteCurrency   string `json:"quote-currency"`
@@ -58,6 +59,12 @@ type PairsData []struct {
 	AmountPrecision int    `json:"amount-precision"`
 	SymbolPartition string `json:"symbol-partition"`
 	Symbol          string `json:"symbol"`
+
+	MinOrderAmt           decimal.Decimal `json:"min-order-amt"`
+	MaxOrderAmt           decimal.Decimal `json:"max-order-amt"`
+	MinOrderValue         decimal.Decimal `json:"min-order-value"`
+	SellMarketMinOrderAmt decimal.Decimal `json:"sell-market-min-order-amt"`
+	SellMarketMaxOrderAmt decimal.Decimal `json:"sell-market-max-order-amt"`
 }
 
 type OrderBook struct {
@@ -106,3 +113,44 @@ type OrderStatus struct {
 	Exchange        string `json:"exchange"`
 	Batch           string `json:"batch"`
 }
+
+type MarginAccountBalance struct {
+	ID             int64  `json:"id"`
+	Type           string `json:"type"`
+	State          string `json:"state"`
+	RiskRate       string `json:"risk-rate"`
+	AcctBalanceSum string `json:"acct-balance-sum"`
+	DebtBalanceSum string `json:"debt-balance-sum"`
+	List           []struct {
+		Currency string `json:"currency"`
+		Type     string `json:"type"`
+		Balance  string `json:"balance"`
+	} `json:"list"`
+}
+
+type MarginLoanInfo []struct {
+	Currency     string `json:"currency"`
+	InterestRate string `json:"interest-rate"`
+	MinLoanAmt   string `json:"min-loan-amt"`
+	MaxLoanAmt   string `json:"max-loan-amt"`
+	LoanableAmt  string `json:"loanable-amt"`
+	ActualRate   string `json:"actual-rate"`
+}
+
+type RepaymentResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    []struct {
+		RepayID   string `json:"repayId"`
+		RepayTime int64  `json:"repayTime"`
+	} `json:"data"`
+}
+
+type SubUserList struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    []struct {
+		UID       int64  `json:"uid"`
+		UserState string `json:"userState"`
+	} `json:"data"`
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -70,14 +71,14 @@ func (e *Huobidm) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(GetContractName(data.ContractType) + data.Symbol)
+			c = exchange.GetCoin(e.GetName(), GetContractName(data.ContractType)+data.Symbol)
 			if c == nil {
 				c = &coin.Coin{
-					Code:     GetContractName(data.ContractType) + data.Symbol,
+					Code:     exchange.GetCoinCode(e.GetName(), GetContractName(data.ContractType)+data.Symbol),
 					Name:     data.Symbol,
 					Explorer: data.ContractType,
 				}
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(GetContractName(data.ContractType) + data.Symbol)
@@ -129,8 +130,8 @@ func (e *Huobidm) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := &coin.Coin{000, "USD", "USD", "", "", "", 0, 0}
-				target := coin.GetCoin(GetContractName(data.ContractType) + data.Symbol)
+				base := &coin.Coin{ID: 000, Code: "USD", Name: "USD"}
+				target := exchange.GetCoin(e.GetName(), GetContractName(data.ContractType)+data.Symbol)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -144,8 +145,8 @@ func (e *Huobidm) GetPairsData() error {
 					ExSymbol:    data.Symbol + "_" + data.ContractType,
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     data.ContractSize,
-					PriceFilter: data.PriceTick,
+					LotSize:     decimal.NewFromFloat(data.ContractSize),
+					PriceFilter: decimal.NewFromFloat(data.PriceTick),
 					Listed:      DEFAULT_LISTED,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -192,15 +193,15 @@ func (e *Huobidm) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	var err error
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
-		buydata.Quantity = bid[1]
-		buydata.Rate = bid[0]
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
+		buydata.Rate = decimal.NewFromFloat(bid[0])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 
 	for _, ask := range orderBook.Asks {
 		selldata := exchange.Order{}
-		selldata.Quantity = ask[1]
-		selldata.Rate = ask[0]
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
+		selldata.Rate = decimal.NewFromFloat(ask[0])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 
@@ -235,7 +236,7 @@ func (e *Huobidm) UpdateAllBalances() {
 	for _, balance := range accountBalance {
 		c := e.GetCoinBySymbol(balance.Asset)
 		if c != nil {
-			balanceMap.Set(c.Code, balance.Available)
+			e.balanceMap.Set(c.Code, balance.Available)
 		}
 	}
 }
@@ -247,6 +248,11 @@ func (e *Huobidm) Withdraw(coin *coin.Coin, quantity float64, addr, tag string)
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/API Path"
@@ -278,6 +284,11 @@ func (e *Huobidm) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -286,8 +297,8 @@ func (e *Huobidm) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "SELL"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -302,8 +313,8 @@ func (e *Huobidm) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -316,6 +327,11 @@ func (e *Huobidm) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/API Path"
@@ -324,8 +340,8 @@ func (e *Huobidm) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "BUY"
 	mapParams["type"] = "LIMIT"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequestPath, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -340,8 +356,8 @@ func (e *Huobidm) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -8,11 +8,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -55,11 +59,11 @@ func (e *HuobiOTC) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(symbol)
+			c = exchange.GetCoin(e.GetName(), symbol)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = symbol
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), symbol)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(symbol)
@@ -99,8 +103,8 @@ func (e *HuobiOTC) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(currency)
-				target := coin.GetCoin(c)
+				base := exchange.GetCoin(e.GetName(), currency)
+				target := exchange.GetCoin(e.GetName(), c)
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -115,8 +119,8 @@ func (e *HuobiOTC) GetPairsData() error {
 					ExSymbol:    fmt.Sprintf("%s_%s", currency, c),
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     DEFAULT_LOT_SIZE,
-					PriceFilter: DEFAULT_PRICE_FILTER,
+					LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+					PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 					Listed:      true,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -134,16 +138,35 @@ Step 4: Modify API Path(strRequestUrl)
 Step 5: Add Params - Depend on API request
 Step 6: Convert the response to Standard Maker struct*/
 func (e *HuobiOTC) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
+	maker, _, err := e.P2POrderBook(p, nil)
+	return maker, err
+}
+
+/* P2POrderBook - the flattened book together with the P2P adverts behind it, filter nil for all countries and pay methods */
+func (e *HuobiOTC) P2POrderBook(p *pair.Pair, filter *AdvertFilter) (*exchange.Maker, *AdvertBook, error) {
 	jsonResponse := &JsonResponse{}
 	orderBook := OrderBook{}
 
 	strRequestUrl := "/trade-market"
 	strUrl := API_URL + strRequestUrl
 
+	if filter == nil {
+		filter = &AdvertFilter{}
+	}
+
 	mapParams := make(map[string]string)
-	mapParams["country"] = "0"   //all countries
-	mapParams["payMethod"] = "0" //all methods
+	mapParams["country"] = DEFAULT_COUNTRY
+	if filter.Country != "" {
+		mapParams["country"] = filter.Country
+	}
+	mapParams["payMethod"] = PAY_METHOD_ALL
+	if filter.PayMethod != "" {
+		mapParams["payMethod"] = filter.PayMethod
+	}
 	mapParams["currency"] = e.GetSymbolByCoin(p.Base)
+	if filter.Currency != "" {
+		mapParams["currency"] = filter.Currency
+	}
 	mapParams["coinId"] = e.GetSymbolByCoin(p.Target)
 	mapParams["blockType"] = "general"
 	mapParams["online"] = "1"
@@ -152,6 +175,8 @@ func (e *HuobiOTC) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	maker.WorkerIP = exchange.GetExternalIP()
 	maker.BeforeTimestamp = float64(time.Now().UnixNano() / 1e6)
 
+	advertBook := &AdvertBook{Pair: p}
+
 	for side := 0; side < 2; side++ {
 		currPage := 1
 		if side == 0 {
@@ -165,33 +190,50 @@ func (e *HuobiOTC) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 
 			jsonOrderbook := exchange.HttpGetRequest(strUrl, mapParams)
 			if err := json.Unmarshal([]byte(jsonOrderbook), &jsonResponse); err != nil {
-				return nil, fmt.Errorf("%s Get Orderbook Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderbook)
+				return nil, nil, fmt.Errorf("%s Get Orderbook Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderbook)
 			} else if !jsonResponse.Success {
-				return nil, fmt.Errorf("%s Get Pairs Failed: %d %v", e.GetName(), jsonResponse.Code, jsonResponse.Message)
+				return nil, nil, fmt.Errorf("%s Get Orderbook Failed: %d %v", e.GetName(), jsonResponse.Code, jsonResponse.Message)
 			}
 			if err := json.Unmarshal(jsonResponse.Data, &orderBook); err != nil {
-				return nil, fmt.Errorf("%s Get Orderbook Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
+				return nil, nil, fmt.Errorf("%s Get Orderbook Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
 			}
 
 			maker.AfterTimestamp = float64(time.Now().UnixNano() / 1e6)
 
-			if side == 0 {
-				for _, ask := range orderBook {
-					selldata := exchange.Order{}
-					selldata.Quantity = ask.TradeCount
-					selldata.Rate = ask.Price
-					maker.Asks = append(maker.Asks, selldata)
+			for _, data := range orderBook {
+				advert := &Advertisement{
+					ID:                data.ID,
+					Pair:              p,
+					UID:               data.UID,
+					UserName:          data.UserName,
+					MerchantLevel:     data.MerchantLevel,
+					PayMethods:        strings.Split(data.PayMethod, ","),
+					PayTerm:           data.PayTerm,
+					Price:             data.Price,
+					Quantity:          data.TradeCount,
+					MinTradeLimit:     data.MinTradeLimit,
+					MaxTradeLimit:     data.MaxTradeLimit,
+					IsOnline:          data.IsOnline,
+					TradeMonthTimes:   data.TradeMonthTimes,
+					OrderCompleteRate: data.OrderCompleteRate,
 				}
-			} else if side == 1 {
-				for _, bid := range orderBook {
-					buydata := exchange.Order{}
-					buydata.Quantity = bid.TradeCount
-					buydata.Rate = bid.Price
-					maker.Bids = append(maker.Bids, buydata)
+
+				order := exchange.Order{}
+				order.Quantity = decimal.NewFromFloat(data.TradeCount)
+				order.Rate = decimal.NewFromFloat(data.Price)
+
+				if side == 0 {
+					advert.Side = "Sell"
+					advertBook.Asks = append(advertBook.Asks, advert)
+					maker.Asks = append(maker.Asks, order)
+				} else {
+					advert.Side = "Buy"
+					advertBook.Bids = append(advertBook.Bids, advert)
+					maker.Bids = append(maker.Bids, order)
 				}
 			}
 
-			if jsonResponse.CurrPage == jsonResponse.TotalPage {
+			if jsonResponse.CurrPage >= jsonResponse.TotalPage {
 				break
 			} else {
 				currPage = jsonResponse.CurrPage + 1
@@ -199,7 +241,55 @@ func (e *HuobiOTC) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 		}
 	}
 
-	return maker, nil
+	return maker, advertBook, nil
+}
+
+/*************** P2P Advertisement ***************/
+/* BuyPrice - average price paid to buy coin for fiatAmount from the sell adverts */
+func (b *AdvertBook) BuyPrice(fiatAmount float64) (float64, error) {
+	asks := append([]*Advertisement{}, b.Asks...)
+	sort.SliceStable(asks, func(i, j int) bool { return asks[i].Price < asks[j].Price })
+	return EffectivePrice(asks, fiatAmount)
+}
+
+/* SellPrice - average price received selling coin worth fiatAmount to the buy adverts */
+func (b *AdvertBook) SellPrice(fiatAmount float64) (float64, error) {
+	bids := append([]*Advertisement{}, b.Bids...)
+	sort.SliceStable(bids, func(i, j int) bool { return bids[i].Price > bids[j].Price })
+	return EffectivePrice(bids, fiatAmount)
+}
+
+/* EffectivePrice - fill fiatAmount across adverts in the given order,
+each trade has to stay within the advert's min/max limit and its remaining quantity */
+func EffectivePrice(adverts []*Advertisement, fiatAmount float64) (float64, error) {
+	if fiatAmount <= 0 {
+		return 0, fmt.Errorf("invalid fiat amount %v", fiatAmount)
+	}
+
+	remain := fiatAmount
+	quantity := 0.0
+	for _, advert := range adverts {
+		if advert.Price <= 0 {
+			continue
+		}
+		limit := advert.Price * advert.Quantity
+		if advert.MaxTradeLimit > 0 && advert.MaxTradeLimit < limit {
+			limit = advert.MaxTradeLimit
+		}
+
+		fill := math.Min(remain, limit)
+		if fill <= 0 || fill < advert.MinTradeLimit {
+			continue
+		}
+
+		quantity += fill / advert.Price
+		remain -= fill
+		if remain <= 0 {
+			return fiatAmount / quantity, nil
+		}
+	}
+
+	return 0, fmt.Errorf("not enough advert liquidity for %v, %v left", fiatAmount, remain)
 }
 
 /*************** Private API ***************/
@@ -14,4 +14,10 @@ const (
 	DEFAULT_WITHDRAW     = true
 	DEFAULT_DEPOSIT      = true
 	DEFAULT_CONFIRMATION = 2
+	DEFAULT_COUNTRY      = "0" //all countries
+
+	PAY_METHOD_ALL    = "0"
+	PAY_METHOD_BANK   = "1"
+	PAY_METHOD_ALIPAY = "2"
+	PAY_METHOD_WECHAT = "3"
 )
@@ -1,6 +1,10 @@
 package huobiotc
 
-import "encoding/json"
+import (
+	"encoding/json"
+
+	"github.com/bitontop/gored/pair"
+)
 
 // Copyright (c) 2015-2019 Bitontop Technologies Inc.
 // Distributed under the MIT software license, see the accompanying
@@ -39,3 +43,33 @@ type OrderBook []struct {
 	TakerLimit        int     `json:"takerLimit"`
 	GmtSort           int64   `json:"gmtSort"`
 }
+
+type AdvertFilter struct {
+	Country   string // country id, empty for all
+	PayMethod string // PAY_METHOD_*, empty for all
+	Currency  string // fiat currency id, empty for the pair's base
+}
+
+type Advertisement struct {
+	ID                int
+	Pair              *pair.Pair
+	Side              string // Sell: merchant sells coin, Buy: merchant buys coin
+	UID               int
+	UserName          string
+	MerchantLevel     int
+	PayMethods        []string
+	PayTerm           int // minutes to pay
+	Price             float64
+	Quantity          float64
+	MinTradeLimit     float64 // in fiat
+	MaxTradeLimit     float64 // in fiat
+	IsOnline          bool
+	TradeMonthTimes   int
+	OrderCompleteRate int
+}
+
+type AdvertBook struct {
+	Pair *pair.Pair
+	Bids []*Advertisement
+	Asks []*Advertisement
+}
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -70,11 +70,11 @@ func (e *Ibankdigital) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data)
+			c = exchange.GetCoin(e.GetName(), data)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), data)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data)
@@ -125,8 +125,8 @@ func (e *Ibankdigital) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteCurrency)
-			target := coin.GetCoin(data.BaseCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -140,8 +140,8 @@ func (e *Ibankdigital) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.AmountPrecision),
-				PriceFilter: math.Pow10(-1 * data.PricePrecision),
+				LotSize:     decimal.New(1, int32(-1*data.AmountPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.PricePrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -168,7 +168,7 @@ func (e *Ibankdigital) updateCoinInfo() error {
 
 	for _, data := range coinsData {
 		c := &coin.Coin{}
-		c = coin.GetCoin(strings.ToLower(data.DisplayName))
+		c = exchange.GetCoin(e.GetName(), strings.ToLower(data.DisplayName))
 
 		if c != nil {
 			coinConstraint := &exchange.CoinConstraint{
@@ -223,16 +223,16 @@ func (e *Ibankdigital) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate = bid[0]
-		buydata.Quantity = bid[1]
+		buydata.Rate = decimal.NewFromFloat(bid[0])
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Tick.Asks {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate = ask[0]
-		selldata.Quantity = ask[1]
+		selldata.Rate = decimal.NewFromFloat(ask[0])
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 	return maker, nil
@@ -298,7 +298,7 @@ func (e *Ibankdigital) UpdateAllBalances() {
 			}
 			c := e.GetCoinBySymbol(balance.Currency)
 			if c != nil {
-				balanceMap.Set(c.Code, freeAmount)
+				e.balanceMap.Set(c.Code, freeAmount)
 			}
 		}
 	}
@@ -310,6 +310,11 @@ func (e *Ibankdigital) Withdraw(coin *coin.Coin, quantity float64, addr, tag str
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := JsonResponse{}
 	strRequest := "v1/dw/withdraw/api/create"
 
@@ -335,17 +340,19 @@ func (e *Ibankdigital) LimitSell(pair *pair.Pair, quantity, rate float64) (*exch
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := ""
 	strRequest := "/v1/order/orders/place"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["account-id"] = e.Account_ID
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "sell-limit"
 
@@ -362,8 +369,8 @@ func (e *Ibankdigital) LimitSell(pair *pair.Pair, quantity, rate float64) (*exch
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -377,17 +384,19 @@ func (e *Ibankdigital) LimitBuy(pair *pair.Pair, quantity, rate float64) (*excha
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := ""
 	strRequest := "/v1/order/orders/place"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["account-id"] = e.Account_ID
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "buy-limit"
 
@@ -404,8 +413,8 @@ func (e *Ibankdigital) LimitBuy(pair *pair.Pair, quantity, rate float64) (*excha
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
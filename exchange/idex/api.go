@@ -5,19 +5,24 @@ package idex
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
+	"math/big"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -64,12 +69,12 @@ func (e *Idex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(symbol)
+			c = exchange.GetCoin(e.GetName(), symbol)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = symbol
+				c.Code = exchange.GetCoinCode(e.GetName(), symbol)
 				c.Name = data.Name
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(symbol)
@@ -115,8 +120,8 @@ func (e *Idex) GetPairsData() error {
 		switch e.Source {
 		case exchange.EXCHANGE_API:
 			if len(pairStrs) >= 2 {
-				base := coin.GetCoin(pairStrs[0])
-				target := coin.GetCoin(pairStrs[1])
+				base := exchange.GetCoin(e.GetName(), pairStrs[0])
+				target := exchange.GetCoin(e.GetName(), pairStrs[1])
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -132,8 +137,8 @@ func (e *Idex) GetPairsData() error {
 				ExSymbol:    symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 				Listed:      true,
 			}
 
@@ -182,11 +187,11 @@ func (e *Idex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Amount, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -196,11 +201,11 @@ func (e *Idex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, ask := range orderBook.Asks {
 		var selldata exchange.Order
 
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Amount, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Amount)
 		if err != nil {
 			return nil, err
 		}
@@ -215,10 +220,131 @@ func (e *Idex) UpdateAllBalances() {
 		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
 		return
 	}
+
+	errResponse := ErrorResponse{}
+	accountBalances := AccountBalances{}
+	strRequest := "/returnCompleteBalances"
+
+	mapParams := make(map[string]interface{})
+	mapParams["address"] = e.API_KEY
+
+	jsonBalanceReturn := e.ApiKeyPOST(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &errResponse); err == nil && errResponse.Error != "" {
+		log.Printf("%s UpdateAllBalances Failed: %v", e.GetName(), errResponse.Error)
+		return
+	}
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &accountBalances); err != nil {
+		log.Printf("%s UpdateAllBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
+		return
+	}
+
+	for symbol, balance := range accountBalances {
+		c := e.getCoinByCode(symbol)
+		if c == nil {
+			continue
+		}
+		freeamount, err := strconv.ParseFloat(balance.Available, 64)
+		if err != nil {
+			log.Printf("%s UpdateAllBalances err: %+v %v", e.GetName(), balance, err)
+			return
+		}
+		e.balanceMap.Set(c.Code, freeamount)
+	}
+}
+
+/* DepositsWithdrawals - deposit and withdrawal history of the account between start and end (unix seconds), 0 for no bound */
+func (e *Idex) DepositsWithdrawals(start, end int64) (*DepositsWithdrawals, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	history := &DepositsWithdrawals{}
+	strRequest := "/returnDepositsWithdrawals"
+
+	mapParams := make(map[string]interface{})
+	mapParams["address"] = e.API_KEY
+	if start > 0 {
+		mapParams["start"] = start
+	}
+	if end > 0 {
+		mapParams["end"] = end
+	}
+
+	jsonHistoryReturn := e.ApiKeyPOST(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonHistoryReturn), &errResponse); err == nil && errResponse.Error != "" {
+		return nil, fmt.Errorf("%s DepositsWithdrawals Failed: %v", e.GetName(), errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonHistoryReturn), history); err != nil {
+		return nil, fmt.Errorf("%s DepositsWithdrawals Json Unmarshal Err: %v %v", e.GetName(), err, jsonHistoryReturn)
+	}
+
+	return history, nil
 }
 
 func (e *Idex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-	return false
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	// IDEX withdraws from the contract to the trading wallet itself
+	if addr != "" && !strings.EqualFold(addr, e.API_KEY) {
+		log.Printf("%s Withdraw only supports the account address %s, got %s", e.GetName(), e.API_KEY, addr)
+		return false
+	}
+
+	withdraw := WithdrawResponse{}
+	strRequest := "/withdraw"
+
+	nonce, err := e.getNextNonce()
+	if err != nil {
+		log.Printf("%s Withdraw Err: %v", e.GetName(), err)
+		return false
+	}
+
+	token := e.GetSymbolByCoin(coin)
+	amount, err := toBaseUnits(decimal.NewFromFloat(quantity), e.getDecimals(coin))
+	if err != nil {
+		log.Printf("%s Withdraw Err: %v", e.GetName(), err)
+		return false
+	}
+
+	hash, err := WithdrawHash(token, amount, e.API_KEY, nonce)
+	if err != nil {
+		log.Printf("%s Withdraw Hash Err: %v", e.GetName(), err)
+		return false
+	}
+	signature, err := SignMessage(hash, e.API_SECRET)
+	if err != nil {
+		log.Printf("%s Withdraw Sign Err: %v", e.GetName(), err)
+		return false
+	}
+
+	mapParams := make(map[string]interface{})
+	mapParams["address"] = e.API_KEY
+	mapParams["amount"] = amount
+	mapParams["token"] = token
+	mapParams["nonce"] = nonce
+	mapParams["v"] = signature.V
+	mapParams["r"] = signature.R
+	mapParams["s"] = signature.S
+
+	jsonSubmitWithdraw := e.ApiKeyPOST(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if withdraw.Error != "" {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), withdraw.Error)
+		return false
+	}
+
+	return true
 }
 
 func (e *Idex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -226,37 +352,30 @@ func (e *Idex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	jsonResponse := &JsonResponse{}
-	placeOrder := PlaceOrder{}
-	strRequest := "/order"
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 
-	baseDecimal := 0
-	if tmp, ok := coinDecimals.Get(pair.Base.Code); ok {
-		baseDecimal = tmp.(int)
+	amountBuy, err := toBaseUnits(orderQuantity.Mul(orderRate), e.getDecimals(pair.Base))
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitSell Err: %v", e.GetName(), err)
 	}
-	targetDecimal := 0
-	if tmp, ok := coinDecimals.Get(pair.Target.Code); ok {
-		targetDecimal = tmp.(int)
+	amountSell, err := toBaseUnits(orderQuantity, e.getDecimals(pair.Target))
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitSell Err: %v", e.GetName(), err)
 	}
 
-	mapParams := make(map[string]interface{})
-	mapParams["tokenBuy"] = e.GetSymbolByCoin(pair.Base)
-	mapParams["amountBuy"] = fmt.Sprintf("%0.0f", quantity*rate*math.Pow10(baseDecimal))
-	mapParams["tokenSell"] = e.GetSymbolByCoin(pair.Target)
-	mapParams["amountSell"] = fmt.Sprintf("%0.0f", quantity*math.Pow10(targetDecimal))
-	mapParams["expires"] = EXPIRES
-
-	jsonPlaceReturn := e.ApiKeyPOST(strRequest, mapParams)
-	log.Printf("jsonPlaceReturn: %s", jsonPlaceReturn)
-	if err := json.Unmarshal(jsonResponse.Result, &placeOrder); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Result Unmarshal Err: %v %s", e.GetName(), err, jsonPlaceReturn)
+	placeOrder, jsonPlaceReturn, err := e.placeOrder(e.GetSymbolByCoin(pair.Base), amountBuy, e.GetSymbolByCoin(pair.Target), amountSell)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitSell %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      fmt.Sprintf("%v", placeOrder.OrderNumber),
-		Rate:         rate,
-		Quantity:     quantity,
+		OrderID:      placeOrder.OrderHash,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -270,38 +389,31 @@ func (e *Idex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	jsonResponse := &JsonResponse{}
-	placeOrder := PlaceOrder{}
-	strRequest := "/order"
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
 
-	baseDecimal := 0
-	if tmp, ok := coinDecimals.Get(pair.Base.Code); ok {
-		baseDecimal = tmp.(int)
+	amountBuy, err := toBaseUnits(orderQuantity, e.getDecimals(pair.Target))
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitBuy Err: %v", e.GetName(), err)
 	}
-	targetDecimal := 0
-	if tmp, ok := coinDecimals.Get(pair.Target.Code); ok {
-		targetDecimal = tmp.(int)
+	amountSell, err := toBaseUnits(orderQuantity.Mul(orderRate), e.getDecimals(pair.Base))
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitBuy Err: %v", e.GetName(), err)
 	}
 
-	mapParams := make(map[string]interface{})
-	mapParams["tokenBuy"] = e.GetSymbolByCoin(pair.Target)
-	mapParams["amountBuy"] = fmt.Sprintf("%0.0f", quantity*math.Pow10(baseDecimal))
-	mapParams["tokenSell"] = e.GetSymbolByCoin(pair.Base)
-	mapParams["amountSell"] = fmt.Sprintf("%0.0f", rate*quantity*math.Pow10(targetDecimal))
-	mapParams["expires"] = EXPIRES
-
-	jsonPlaceReturn := e.ApiKeyPOST(strRequest, mapParams)
-	log.Printf("jsonPlaceReturn: %s", jsonPlaceReturn)
-	if err := json.Unmarshal(jsonResponse.Result, &placeOrder); err != nil {
-		return nil, fmt.Errorf("%s LimitSell Result Unmarshal Err: %v %s", e.GetName(), err, jsonPlaceReturn)
+	placeOrder, jsonPlaceReturn, err := e.placeOrder(e.GetSymbolByCoin(pair.Target), amountBuy, e.GetSymbolByCoin(pair.Base), amountSell)
+	if err != nil {
+		return nil, fmt.Errorf("%s LimitBuy %v", e.GetName(), err)
 	}
 
 	order := &exchange.Order{
 		Pair:         pair,
-		OrderID:      fmt.Sprintf("%v", placeOrder.OrderNumber),
-		Rate:         rate,
-		Quantity:     quantity,
-		Side:         "Sell",
+		OrderID:      placeOrder.OrderHash,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
+		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
 	}
@@ -309,59 +421,131 @@ func (e *Idex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	return order, nil
 }
 
-func (e *Idex) OrderStatus(order *exchange.Order) error {
-	if e.API_KEY == "" || e.API_SECRET == "" {
-		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+func (e *Idex) placeOrder(tokenBuy, amountBuy, tokenSell, amountSell string) (*PlaceOrder, string, error) {
+	placeOrder := &PlaceOrder{}
+	strRequest := "/order"
+
+	nonce, err := e.getNextNonce()
+	if err != nil {
+		return nil, "", err
 	}
 
-	jsonResponse := &JsonResponse{}
-	orderStatus := OrderStatus{}
-	strRequest := "/orderpending"
+	hash, err := OrderHash(tokenBuy, amountBuy, tokenSell, amountSell, EXPIRES, nonce, e.API_KEY)
+	if err != nil {
+		return nil, "", fmt.Errorf("Order Hash Err: %v", err)
+	}
+	signature, err := SignMessage(hash, e.API_SECRET)
+	if err != nil {
+		return nil, "", fmt.Errorf("Sign Err: %v", err)
+	}
 
 	mapParams := make(map[string]interface{})
-	mapParams["cmd"] = "orderpending/order"
+	mapParams["tokenBuy"] = tokenBuy
+	mapParams["amountBuy"] = amountBuy
+	mapParams["tokenSell"] = tokenSell
+	mapParams["amountSell"] = amountSell
+	mapParams["address"] = e.API_KEY
+	mapParams["nonce"] = nonce
+	mapParams["expires"] = EXPIRES
+	mapParams["v"] = signature.V
+	mapParams["r"] = signature.R
+	mapParams["s"] = signature.S
 
-	orderID, err := strconv.Atoi(order.OrderID)
-	if err != nil {
-		return fmt.Errorf("convert id from string to int error :%v", err)
+	jsonPlaceReturn := e.ApiKeyPOST(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), placeOrder); err != nil {
+		return nil, jsonPlaceReturn, fmt.Errorf("Json Unmarshal Err: %v %s", err, jsonPlaceReturn)
+	} else if placeOrder.Error != "" {
+		return nil, jsonPlaceReturn, fmt.Errorf("Failed: %v", placeOrder.Error)
 	}
 
-	body := make(map[string]interface{})
-	body["id"] = orderID
+	return placeOrder, jsonPlaceReturn, nil
+}
 
-	mapParams["body"] = body
+func (e *Idex) OrderStatus(order *exchange.Order) error {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	orderStatus := OrderStatus{}
+	strRequest := "/returnOrderStatus"
+
+	mapParams := make(map[string]interface{})
+	mapParams["orderHash"] = order.OrderID
 
 	jsonOrderStatus := e.ApiKeyPOST(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonOrderStatus), &jsonResponse); err != nil {
+	if err := json.Unmarshal([]byte(jsonOrderStatus), &orderStatus); err != nil {
 		return fmt.Errorf("%s OrderStatus Json Unmarshal Err: %v %v", e.GetName(), err, jsonOrderStatus)
-	} else if jsonResponse.Error.Code != "" {
-		return fmt.Errorf("%s OrderStatus Failed: %v", e.GetName(), jsonResponse.Error)
-	}
-	if err := json.Unmarshal(jsonResponse.Result, &orderStatus); err != nil {
-		return fmt.Errorf("%s OrderStatus Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	} else if orderStatus.Error != "" {
+		return fmt.Errorf("%s OrderStatus Failed: %v", e.GetName(), orderStatus.Error)
 	}
 
 	order.StatusMessage = jsonOrderStatus
-	if orderStatus[0].Result.Status == 1 {
-		order.Status = exchange.New
-	} else if orderStatus[0].Result.Status == 2 {
-		order.Status = exchange.Partial
-	} else if orderStatus[0].Result.Status == 3 {
+	e.setOrderStatus(order, &orderStatus)
+
+	return nil
+}
+
+func (e *Idex) setOrderStatus(order *exchange.Order, orderStatus *OrderStatus) {
+	filled, _ := strconv.ParseFloat(orderStatus.Filled, 64)
+	switch orderStatus.Status {
+	case "open":
+		if filled > 0 {
+			order.Status = exchange.Partial
+		} else {
+			order.Status = exchange.New
+		}
+	case "complete":
 		order.Status = exchange.Filled
-	} else if orderStatus[0].Result.Status == 4 {
-		order.Status = exchange.Canceling
-	} else if orderStatus[0].Result.Status == 5 {
+	case "cancelled":
 		order.Status = exchange.Canceled
-	} else if orderStatus[0].Result.Status == 6 {
-		order.Status = exchange.Canceling
-
+	default:
+		order.Status = exchange.Other
 	}
 
-	return nil
+	order.DealRate, _ = strconv.ParseFloat(orderStatus.Price, 64)
+	order.DealQuantity = filled
 }
 
 func (e *Idex) ListOrders() ([]*exchange.Order, error) {
-	return nil, nil
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	openOrders := OpenOrders{}
+	strRequest := "/returnOpenOrders"
+
+	mapParams := make(map[string]interface{})
+	mapParams["address"] = e.API_KEY
+
+	jsonOpenOrders := e.ApiKeyPOST(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &errResponse); err == nil && errResponse.Error != "" {
+		return nil, fmt.Errorf("%s ListOrders Failed: %v", e.GetName(), errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &openOrders); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Json Unmarshal Err: %v %v", e.GetName(), err, jsonOpenOrders)
+	}
+
+	orders := []*exchange.Order{}
+	for _, data := range openOrders {
+		rate, _ := strconv.ParseFloat(data.Price, 64)
+		quantity, _ := strconv.ParseFloat(data.InitialAmount, 64)
+		order := &exchange.Order{
+			Pair:     e.GetPairBySymbol(data.Market),
+			OrderID:  data.OrderHash,
+			Rate:     decimal.NewFromFloat(rate),
+			Quantity: decimal.NewFromFloat(quantity),
+		}
+		if data.Type == "buy" {
+			order.Side = "Buy"
+		} else {
+			order.Side = "Sell"
+		}
+		e.setOrderStatus(order, &data)
+		orders = append(orders, order)
+	}
+
+	return orders, nil
 }
 
 func (e *Idex) CancelOrder(order *exchange.Order) error {
@@ -369,33 +553,38 @@ func (e *Idex) CancelOrder(order *exchange.Order) error {
 		return fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
-	jsonResponse := &JsonResponse{}
 	cancelOrder := CancelOrder{}
-	strRequest := "/orderpending"
+	strRequest := "/cancel"
 
-	mapParams := make(map[string]interface{})
-	mapParams["cmd"] = "orderpending/cancelTrade"
-
-	orderID, err := strconv.Atoi(order.OrderID)
+	nonce, err := e.getNextNonce()
 	if err != nil {
-		return fmt.Errorf("convert id from string to int error :%v", err)
+		return fmt.Errorf("%s CancelOrder Err: %v", e.GetName(), err)
 	}
 
-	body := make(map[string]interface{})
-	body["orders_id"] = orderID
+	hash, err := CancelHash(order.OrderID, nonce)
+	if err != nil {
+		return fmt.Errorf("%s CancelOrder Hash Err: %v", e.GetName(), err)
+	}
+	signature, err := SignMessage(hash, e.API_SECRET)
+	if err != nil {
+		return fmt.Errorf("%s CancelOrder Sign Err: %v", e.GetName(), err)
+	}
 
-	mapParams["body"] = body
+	mapParams := make(map[string]interface{})
+	mapParams["orderHash"] = order.OrderID
+	mapParams["address"] = e.API_KEY
+	mapParams["nonce"] = nonce
+	mapParams["v"] = signature.V
+	mapParams["r"] = signature.R
+	mapParams["s"] = signature.S
 
 	jsonCancelOrder := e.ApiKeyPOST(strRequest, mapParams)
-	if err := json.Unmarshal([]byte(jsonCancelOrder), &jsonResponse); err != nil {
+	if err := json.Unmarshal([]byte(jsonCancelOrder), &cancelOrder); err != nil {
 		return fmt.Errorf("%s CancelOrder Json Unmarshal Err: %v %v", e.GetName(), err, jsonCancelOrder)
-	} else if jsonResponse.Error.Code != "" {
-		return fmt.Errorf("%s CancelOrder Failed: %v", e.GetName(), jsonResponse.Error)
-	}
-	if err := json.Unmarshal(jsonResponse.Result, &cancelOrder); err != nil {
-		return fmt.Errorf("%s CancelOrder Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
-	} else if cancelOrder[0].Result != "撤销中" {
-		return fmt.Errorf("%s Cancel Order error :%v", e.GetName(), cancelOrder[0].Result)
+	} else if cancelOrder.Error != "" {
+		return fmt.Errorf("%s CancelOrder Failed: %v", e.GetName(), cancelOrder.Error)
+	} else if cancelOrder.Success != 1 {
+		return fmt.Errorf("%s CancelOrder Failed: %v", e.GetName(), jsonCancelOrder)
 	}
 
 	order.Status = exchange.Canceling
@@ -405,6 +594,53 @@ func (e *Idex) CancelOrder(order *exchange.Order) error {
 }
 
 func (e *Idex) CancelAllOrder() error {
+	orders, err := e.ListOrders()
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		if err := e.CancelOrder(order); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* getNextNonce - the nonce every signed request has to carry, must be larger than the last one used by the address */
+func (e *Idex) getNextNonce() (int64, error) {
+	nextNonce := NextNonce{}
+	errResponse := ErrorResponse{}
+	strRequest := "/returnNextNonce"
+
+	mapParams := make(map[string]interface{})
+	mapParams["address"] = e.API_KEY
+
+	jsonNonceReturn := e.ApiKeyPOST(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonNonceReturn), &errResponse); err == nil && errResponse.Error != "" {
+		return 0, fmt.Errorf("Get Next Nonce Failed: %v", errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonNonceReturn), &nextNonce); err != nil {
+		return 0, fmt.Errorf("Get Next Nonce Json Unmarshal Err: %v %v", err, jsonNonceReturn)
+	}
+
+	return nextNonce.Nonce, nil
+}
+
+func (e *Idex) getDecimals(c *coin.Coin) int {
+	if tmp, ok := coinDecimals.Get(c.Code); ok {
+		return tmp.(int)
+	}
+	return DEFAULT_DECIMALS
+}
+
+/* getCoinByCode - IDEX ExSymbol is the token address, balances and history are keyed by the token symbol */
+func (e *Idex) getCoinByCode(symbol string) *coin.Coin {
+	c := exchange.GetCoin(e.GetName(), symbol)
+	if c != nil && e.GetCoinConstraint(c) != nil {
+		return c
+	}
 	return nil
 }
 
@@ -416,28 +652,17 @@ Step 3: Add HttpGetRequest below strUrl if API has different requests*/
 func (e *Idex) ApiKeyPOST(strRequestPath string, mapParams map[string]interface{}) string {
 	strRequestUrl := API_URL + strRequestPath
 
-	mapParams["address"] = e.API_KEY
-	mapParams["nonce"] = fmt.Sprintf("%d", time.Now().Unix())
-	// signature := SoliditySha3(mapParams, e.API_SECRET)
-	// log.Printf("signature: %d %s", len(signature), signature)
-	// log.Printf("v: %d %d", signature[64], int(signature[64]))
-	// mapParams["v"] = int(signature[64]) + 27
-	// mapParams["r"] = signature[:32]
-	// mapParams["s"] = signature[32:64]
-
 	jsonParams := ""
 	if nil != mapParams {
 		bytesParams, _ := json.Marshal(mapParams)
 		jsonParams = string(bytesParams)
 	}
 
-	log.Printf("jsonParams: %s", jsonParams)
-
 	request, err := http.NewRequest("POST", strRequestUrl, strings.NewReader(jsonParams))
 	if err != nil {
 		return err.Error()
 	}
-	request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Add("Content-Type", "application/json")
 	request.Header.Add("Accept", "application/json")
 
 	httpClient := &http.Client{}
@@ -455,19 +680,110 @@ func (e *Idex) ApiKeyPOST(strRequestPath string, mapParams map[string]interface{
 	return string(body)
 }
 
-/* func SoliditySha3(mapParams map[string]interface{}, strSecret string) string {
-	hash := solsha3.SoliditySHA3(
-		solsha3.Address(CONTRACT_ADDRESS),
-		solsha3.Address(mapParams["tokenBuy"]),
-		solsha3.Uint256(mapParams["amountBuy"]),
-		solsha3.Address(mapParams["tokenSell"]),
-		solsha3.Uint256(mapParams["amountSell"]),
-		solsha3.Uint256(mapParams["expires"]),
-		solsha3.Uint256(mapParams["nonce"]),
-		solsha3.Address(mapParams["address"]),
+type Signature struct {
+	V uint8
+	R string
+	S string
+}
+
+/* OrderHash - soliditySha3(contract, tokenBuy, amountBuy, tokenSell, amountSell, expires, nonce, address) */
+func OrderHash(tokenBuy, amountBuy, tokenSell, amountSell string, expires, nonce int64, address string) ([]byte, error) {
+	return soliditySha3(
+		solidityAddress(CONTRACT_ADDRESS),
+		solidityAddress(tokenBuy),
+		solidityUint256(amountBuy),
+		solidityAddress(tokenSell),
+		solidityUint256(amountSell),
+		solidityUint256(strconv.FormatInt(expires, 10)),
+		solidityUint256(strconv.FormatInt(nonce, 10)),
+		solidityAddress(address),
+	)
+}
+
+/* WithdrawHash - soliditySha3(contract, token, amount, address, nonce) */
+func WithdrawHash(token, amount, address string, nonce int64) ([]byte, error) {
+	return soliditySha3(
+		solidityAddress(CONTRACT_ADDRESS),
+		solidityAddress(token),
+		solidityUint256(amount),
+		solidityAddress(address),
+		solidityUint256(strconv.FormatInt(nonce, 10)),
 	)
+}
+
+/* CancelHash - soliditySha3(orderHash, nonce) */
+func CancelHash(orderHash string, nonce int64) ([]byte, error) {
+	return soliditySha3(
+		solidityUint256(orderHash),
+		solidityUint256(strconv.FormatInt(nonce, 10)),
+	)
+}
+
+/* SignMessage - sign message with the Ethereum personal message prefix, as IDEX verifies with ecrecover */
+func SignMessage(message []byte, privateKey string) (*Signature, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(message))
+	sig, err := crypto.Sign(crypto.Keccak256([]byte(prefix), message), key)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := &Signature{
+		V: sig[64] + 27,
+		R: "0x" + hex.EncodeToString(sig[:32]),
+		S: "0x" + hex.EncodeToString(sig[32:64]),
+	}
+	return signature, nil
+}
+
+type solidityParam func() ([]byte, error)
+
+func soliditySha3(params ...solidityParam) ([]byte, error) {
+	packed := []byte{}
+	for _, param := range params {
+		b, err := param()
+		if err != nil {
+			return nil, err
+		}
+		packed = append(packed, b...)
+	}
+	return crypto.Keccak256(packed), nil
+}
+
+func solidityAddress(address string) solidityParam {
+	return func() ([]byte, error) {
+		b, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(address), "0x"))
+		if err != nil || len(b) != 20 {
+			return nil, fmt.Errorf("invalid address %q", address)
+		}
+		return b, nil
+	}
+}
 
-	// Sign Hash Message by Private Key to get V S R message
+/* solidityUint256 - decimal string or 0x prefixed hex string, left padded to 32 bytes */
+func solidityUint256(value string) solidityParam {
+	return func() ([]byte, error) {
+		n, ok := new(big.Int), false
+		if strings.HasPrefix(value, "0x") {
+			n, ok = n.SetString(value[2:], 16)
+		} else {
+			n, ok = n.SetString(value, 10)
+		}
+		if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+			return nil, fmt.Errorf("invalid uint256 %q", value)
+		}
+		return common.LeftPadBytes(n.Bytes(), 32), nil
+	}
+}
 
-	return fmt.Sprintln(hex.EncodeToString(hash))
-} */
+/* toBaseUnits - amount in the token's smallest unit, eg: 1.5 ETH -> 1500000000000000000 */
+func toBaseUnits(amount decimal.Decimal, decimals int) (string, error) {
+	if amount.IsNegative() {
+		return "", fmt.Errorf("invalid amount %v", amount)
+	}
+	return amount.Shift(int32(decimals)).Truncate(0).String(), nil
+}
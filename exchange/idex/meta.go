@@ -14,6 +14,7 @@ const (
 	DEFAULT_WITHDRAW     = true
 	DEFAULT_DEPOSIT      = true
 	DEFAULT_CONFIRMATION = 2
+	DEFAULT_DECIMALS     = 18
 
 	CONTRACT_ADDRESS = "0x2a0c0dbecc7e4d658f48e01e3fa353f44050c208"
 	EXPIRES          = 100000
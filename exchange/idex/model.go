@@ -72,24 +72,46 @@ type OrderBook struct {
 	} `json:"bids"`
 }
 
-type AccountBalances []struct {
-	Result struct {
-		TotalBtc   string `json:"total_btc"`
-		TotalCny   string `json:"total_cny"`
-		TotalUsd   string `json:"total_usd"`
-		AssetsList []struct {
-			CoinSymbol string `json:"coin_symbol"`
-			Balance    string `json:"balance"`
-			Freeze     string `json:"freeze"`
-			BTCValue   string `json:"BTCValue"`
-			CNYValue   string `json:"CNYValue"`
-			USDValue   string `json:"USDValue"`
-		} `json:"assets_list"`
-	} `json:"result"`
-	Cmd string `json:"cmd"`
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type AccountBalances map[string]struct {
+	Available string `json:"available"`
+	OnOrders  string `json:"onOrders"`
+}
+
+type DepositsWithdrawals struct {
+	Deposits []struct {
+		DepositNumber   int    `json:"depositNumber"`
+		Currency        string `json:"currency"`
+		Amount          string `json:"amount"`
+		Timestamp       int64  `json:"timestamp"`
+		TransactionHash string `json:"transactionHash"`
+	} `json:"deposits"`
+	Withdrawals []struct {
+		WithdrawalNumber int    `json:"withdrawalNumber"`
+		Currency         string `json:"currency"`
+		Amount           string `json:"amount"`
+		Timestamp        int64  `json:"timestamp"`
+		TransactionHash  string `json:"transactionHash"`
+		Status           string `json:"status"`
+	} `json:"withdrawals"`
+}
+
+type NextNonce struct {
+	Nonce int64 `json:"nonce"`
+}
+
+type WithdrawResponse struct {
+	Error  string `json:"error"`
+	Amount string `json:"amount"`
+	Token  string `json:"token"`
+	Nonce  int64  `json:"nonce"`
 }
 
 type PlaceOrder struct {
+	Error       string `json:"error"`
 	Timestamp   int    `json:"timestamp"`
 	Market      string `json:"market"`
 	OrderNumber int    `json:"orderNumber"`
@@ -111,31 +133,24 @@ type PlaceOrder struct {
 	} `json:"params"`
 }
 
-type OrderStatus []struct {
-	Result struct {
-		ID             int    `json:"id"`
-		CreatedAt      int64  `json:"createdAt"`
-		AccountType    int    `json:"account_type"`
-		Pair           string `json:"pair"`
-		CoinSymbol     string `json:"coin_symbol"`
-		CurrencySymbol string `json:"currency_symbol"`
-		OrderSide      int    `json:"order_side"`
-		OrderType      int    `json:"order_type"`
-		Price          string `json:"price"`
-		DealPrice      string `json:"deal_price"`
-		Amount         string `json:"amount"`
-		Money          string `json:"money"`
-		DealAmount     string `json:"deal_amount"`
-		DealPercent    string `json:"deal_percent"`
-		DealMoney      string `json:"deal_money"`
-		Status         int    `json:"status"`
-		Unexecuted     string `json:"unexecuted"`
-		OrderFrom      int    `json:"order_from"`
-	} `json:"result"`
-	Cmd string `json:"cmd"`
+type OrderStatus struct {
+	Error         string `json:"error"`
+	Timestamp     int64  `json:"timestamp"`
+	Market        string `json:"market"`
+	OrderNumber   int    `json:"orderNumber"`
+	OrderHash     string `json:"orderHash"`
+	Price         string `json:"price"`
+	Amount        string `json:"amount"`
+	Total         string `json:"total"`
+	Type          string `json:"type"`
+	Filled        string `json:"filled"`
+	InitialAmount string `json:"initialAmount"`
+	Status        string `json:"status"`
 }
 
-type CancelOrder []struct {
-	Result string `json:"result"`
-	Cmd    string `json:"cmd"`
+type OpenOrders []OrderStatus
+
+type CancelOrder struct {
+	Error   string `json:"error"`
+	Success int    `json:"success"`
 }
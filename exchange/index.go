@@ -0,0 +1,121 @@
+package exchange
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	cmap "github.com/orcaman/concurrent-map"
+)
+
+/* The adapters keep their constraints by coin / pair ID and index them by exchange symbol,
+so GetCoinBySymbol and GetPairBySymbol don't scan the constraint map.
+The methods below change both maps together. */
+var indexLock sync.Mutex
+
+// ConstraintMaps - the constraints of an exchange by ID and their symbol indexes
+type ConstraintMaps struct {
+	CoinConstraint cmap.ConcurrentMap // coin ID -> *CoinConstraint
+	PairConstraint cmap.ConcurrentMap // pair ID -> *PairConstraint
+	CoinSymbol     cmap.ConcurrentMap // exchange symbol -> *CoinConstraint
+	PairSymbol     cmap.ConcurrentMap // exchange symbol -> *PairConstraint
+}
+
+func NewConstraintMaps() *ConstraintMaps {
+	return &ConstraintMaps{
+		CoinConstraint: cmap.New(),
+		PairConstraint: cmap.New(),
+		CoinSymbol:     cmap.New(),
+		PairSymbol:     cmap.New(),
+	}
+}
+
+// ConstraintStore - the ConstraintMaps an adapter reads. Data loaded from JSON_FILE, PSQL or MICROSERVICE_API
+// is swapped in with Store, so a lookup during a refresh sees the four old maps or the four new ones, never a mix.
+// EXCHANGE_API data is set into the current maps one constraint at a time
+type ConstraintStore struct {
+	maps atomic.Value // *ConstraintMaps
+}
+
+func (s *ConstraintStore) Load() *ConstraintMaps {
+	maps, _ := s.maps.Load().(*ConstraintMaps)
+	return maps
+}
+
+func (s *ConstraintStore) Store(maps *ConstraintMaps) {
+	s.maps.Store(maps)
+}
+
+func (m *ConstraintMaps) SetCoinConstraint(coinConstraint *CoinConstraint) {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+
+	key := fmt.Sprintf("%d", coinConstraint.CoinID)
+	if tmp, ok := m.CoinConstraint.Get(key); ok {
+		removeSymbol(m.CoinSymbol, tmp.(*CoinConstraint).ExSymbol, tmp)
+	}
+	m.CoinConstraint.Set(key, coinConstraint)
+	m.CoinSymbol.Set(coinConstraint.ExSymbol, coinConstraint)
+}
+
+func (m *ConstraintMaps) DeleteCoinConstraint(coinID int) {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+
+	key := fmt.Sprintf("%d", coinID)
+	if tmp, ok := m.CoinConstraint.Pop(key); ok {
+		removeSymbol(m.CoinSymbol, tmp.(*CoinConstraint).ExSymbol, tmp)
+	}
+}
+
+func (m *ConstraintMaps) SetPairConstraint(pairConstraint *PairConstraint) {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+
+	key := fmt.Sprintf("%d", pairConstraint.PairID)
+	if tmp, ok := m.PairConstraint.Get(key); ok {
+		removeSymbol(m.PairSymbol, tmp.(*PairConstraint).ExSymbol, tmp)
+	}
+	m.PairConstraint.Set(key, pairConstraint)
+	m.PairSymbol.Set(pairConstraint.ExSymbol, pairConstraint)
+}
+
+func (m *ConstraintMaps) DeletePairConstraint(pairID int) {
+	indexLock.Lock()
+	defer indexLock.Unlock()
+
+	key := fmt.Sprintf("%d", pairID)
+	if tmp, ok := m.PairConstraint.Pop(key); ok {
+		removeSymbol(m.PairSymbol, tmp.(*PairConstraint).ExSymbol, tmp)
+	}
+}
+
+// IndexCoinSymbols - builds the symbol index of constraints loaded in bulk, eg: from JSON file
+func IndexCoinSymbols(constraints cmap.ConcurrentMap) cmap.ConcurrentMap {
+	symbols := cmap.New()
+	for _, tmp := range constraints.Items() {
+		coinConstraint := tmp.(*CoinConstraint)
+		symbols.Set(coinConstraint.ExSymbol, coinConstraint)
+	}
+	return symbols
+}
+
+func IndexPairSymbols(constraints cmap.ConcurrentMap) cmap.ConcurrentMap {
+	symbols := cmap.New()
+	for _, tmp := range constraints.Items() {
+		pairConstraint := tmp.(*PairConstraint)
+		symbols.Set(pairConstraint.ExSymbol, pairConstraint)
+	}
+	return symbols
+}
+
+// removeSymbol - drops the index only if it still points to the old constraint
+func removeSymbol(symbols cmap.ConcurrentMap, symbol string, constraint interface{}) {
+	symbols.RemoveCb(symbol, func(key string, v interface{}, exists bool) bool {
+		return exists && v == constraint
+	})
+}
@@ -13,10 +13,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"mime"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -24,11 +24,11 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
-const (
-	API_URL string = "https://api.kraken.com"
-)
+// API_URL - the base endpoint, a var so the tests can point it to a local server
+var API_URL = "https://api.kraken.com"
 
 /*API Base Knowledge
 Path: API function. Usually after the base endpoint URL
@@ -73,17 +73,20 @@ func (e *Kraken) GetCoinsData() error {
 	}
 
 	for key, data := range coinsData {
+		assetNameMap.Set(data.Altname, key)
+
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Altname)
+			code := getStandardCode(data.Altname)
+			c = exchange.GetCoin(e.GetName(), code)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Altname
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), code)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
-			c = e.GetCoinBySymbol(data.Altname)
+			c = e.GetCoinBySymbol(key)
 		}
 
 		if c != nil {
@@ -128,6 +131,8 @@ func (e *Kraken) GetPairsData() error {
 	for key, data := range pairsData {
 		ch := strings.Split(key, ".")
 		if len(ch) == 1 {
+			setPairName(key, data)
+
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
@@ -144,10 +149,11 @@ func (e *Kraken) GetPairsData() error {
 					PairID:      p.ID,
 					Pair:        p,
 					ExSymbol:    key,
-					LotSize:     math.Pow10(-1 * data.LotDecimals),
-					PriceFilter: math.Pow10(-1 * data.PairDecimals),
+					LotSize:     decimal.New(1, int32(-1*data.LotDecimals)),
+					PriceFilter: decimal.New(1, int32(-1*data.PairDecimals)),
 					Listed:      DEFAULT_LISTED,
 				}
+				pairConstraint.MinQuantity, _ = decimal.NewFromString(data.Ordermin)
 				if len(data.FeesMaker) >= 1 {
 					pairConstraint.MakerFee = data.FeesMaker[0][1]
 				}
@@ -161,6 +167,38 @@ func (e *Kraken) GetPairsData() error {
 	return nil
 }
 
+/* LoadAssetNames - build the altname mapping from the loaded constraints, used with JSON_FILE data */
+func (e *Kraken) LoadAssetNames() {
+	maps := constraints.Load()
+	for _, key := range maps.CoinSymbol.Keys() {
+		assetNameMap.Set(getAltname(key), key)
+	}
+	for _, tmp := range maps.PairSymbol.Items() {
+		pairConstraint := tmp.(*exchange.PairConstraint)
+		if pairConstraint.Pair == nil {
+			continue
+		}
+		base, target := e.GetSymbolByCoin(pairConstraint.Pair.Base), e.GetSymbolByCoin(pairConstraint.Pair.Target)
+		if base == "" || target == "" {
+			continue
+		}
+		// eg: XXBTZUSD -> altname XBTUSD, wsname XBT/USD
+		setPairName(pairConstraint.ExSymbol, &PairsData{
+			Altname: getAltname(target) + getAltname(base),
+			Wsname:  getAltname(target) + "/" + getAltname(base),
+		})
+	}
+}
+
+func setPairName(key string, data *PairsData) {
+	if data.Altname != "" {
+		pairNameMap.Set(data.Altname, key)
+	}
+	if data.Wsname != "" {
+		pairNameMap.Set(data.Wsname, key)
+	}
+}
+
 /*Get Pair Market Depth
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
 Step 2: Add Model of API Response
@@ -199,14 +237,14 @@ func (e *Kraken) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, book := range orderBook {
 		for _, bid := range book.Bids {
 			buydata := exchange.Order{}
-			buydata.Quantity, err = strconv.ParseFloat(bid[1].(string), 64)
+			buydata.Quantity, err = decimal.NewFromString(bid[1].(string))
 			if err != nil {
-				return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+				return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 			}
 
-			buydata.Rate, err = strconv.ParseFloat(bid[0].(string), 64)
+			buydata.Rate, err = decimal.NewFromString(bid[0].(string))
 			if err != nil {
-				return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+				return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 			}
 			maker.Bids = append(maker.Bids, buydata)
 		}
@@ -214,14 +252,14 @@ func (e *Kraken) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, book := range orderBook {
 		for _, ask := range book.Asks {
 			selldata := exchange.Order{}
-			selldata.Quantity, err = strconv.ParseFloat(ask[1].(string), 64)
+			selldata.Quantity, err = decimal.NewFromString(ask[1].(string))
 			if err != nil {
-				return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+				return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 			}
 
-			selldata.Rate, err = strconv.ParseFloat(ask[0].(string), 64)
+			selldata.Rate, err = decimal.NewFromString(ask[0].(string))
 			if err != nil {
-				return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+				return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 			}
 			maker.Asks = append(maker.Asks, selldata)
 		}
@@ -254,10 +292,14 @@ func (e *Kraken) UpdateAllBalances() {
 	}
 
 	for symb, balance := range accountBalance {
+		// staked and on hold funds are reported as XXBT.S, USD.HOLD and can't be traded
+		if strings.Contains(symb, ".") {
+			continue
+		}
 		c := e.GetCoinBySymbol(symb)
 		bal, _ := strconv.ParseFloat(balance, 64)
 		if c != nil {
-			balanceMap.Set(c.Code, bal)
+			e.balanceMap.Set(c.Code, bal)
 		}
 	}
 }
@@ -268,6 +310,11 @@ func (e *Kraken) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := WithdrawResponse{}
 	strRequestPath := "/0/private/Withdraw"
@@ -299,19 +346,21 @@ func (e *Kraken) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/0/private/AddOrder"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	params := url.Values{
 		"pair":      {e.GetSymbolByPair(pair)},
 		"type":      {"sell"},
 		"ordertype": {"limit"},
-		"price":     {strconv.FormatFloat(rate, 'f', priceFilter, 64)},
-		"volume":    {strconv.FormatFloat(quantity, 'f', lotSize, 64)},
+		"price":     {orderRate.String()},
+		"volume":    {orderQuantity.String()},
 	}
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequestPath, params, &PlaceOrder{})
@@ -327,8 +376,8 @@ func (e *Kraken) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strings.Join(placeOrder.TransactionIds, ""),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -341,19 +390,21 @@ func (e *Kraken) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequestPath := "/0/private/AddOrder"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	params := url.Values{
 		"pair":      {e.GetSymbolByPair(pair)},
 		"type":      {"buy"},
 		"ordertype": {"limit"},
-		"price":     {strconv.FormatFloat(rate, 'f', priceFilter, 64)},
-		"volume":    {strconv.FormatFloat(quantity, 'f', lotSize, 64)},
+		"price":     {orderRate.String()},
+		"volume":    {orderQuantity.String()},
 	}
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequestPath, params, &PlaceOrder{})
@@ -369,8 +420,8 @@ func (e *Kraken) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strings.Join(placeOrder.TransactionIds, ""),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -419,7 +470,51 @@ func (e *Kraken) OrderStatus(order *exchange.Order) error {
 }
 
 func (e *Kraken) ListOrders() ([]*exchange.Order, error) {
-	return nil, nil
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	openOrders := OpenOrders{}
+	strRequestPath := "/0/private/OpenOrders"
+
+	jsonOpenOrders := e.ApiKeyPost(strRequestPath, url.Values{}, &OpenOrders{})
+	if err := json.Unmarshal([]byte(jsonOpenOrders), &jsonResponse); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Json Unmarshal Err: %v %v", e.GetName(), err, jsonOpenOrders)
+	} else if len(jsonResponse.Error) != 0 {
+		return nil, fmt.Errorf("%s ListOrders Failed: %v", e.GetName(), jsonResponse.Error)
+	}
+	if err := json.Unmarshal(jsonResponse.Result, &openOrders); err != nil {
+		return nil, fmt.Errorf("%s ListOrders Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	}
+
+	orders := []*exchange.Order{}
+	for txid, data := range openOrders.Open {
+		rate, _ := strconv.ParseFloat(data.Description.PrimaryPrice, 64)
+		quantity, _ := strconv.ParseFloat(data.Volume, 64)
+		order := &exchange.Order{
+			// descr.pair is the pair altname, eg: XBTUSD
+			Pair:         e.GetPairBySymbol(data.Description.AssetPair),
+			OrderID:      txid,
+			Rate:         decimal.NewFromFloat(rate),
+			Quantity:     decimal.NewFromFloat(quantity),
+			DealQuantity: data.VolumeExecuted,
+		}
+		if data.Description.Type == "buy" {
+			order.Side = "Buy"
+		} else {
+			order.Side = "Sell"
+		}
+		if data.VolumeExecuted > 0 {
+			order.Status = exchange.Partial
+			order.DealRate = data.Cost / data.VolumeExecuted
+		} else {
+			order.Status = exchange.New
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
 }
 
 func (e *Kraken) CancelOrder(order *exchange.Order) error {
@@ -453,6 +548,54 @@ func (e *Kraken) CancelAllOrder() error {
 	return nil
 }
 
+/* GetLedgers - ledger entries between start and end (unix seconds, 0 for no limit) with the asset resolved to the standard coin */
+func (e *Kraken) GetLedgers(start, end int64) ([]*LedgerEntry, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
+	}
+
+	jsonResponse := &JsonResponse{}
+	ledgers := Ledgers{}
+	strRequestPath := "/0/private/Ledgers"
+
+	params := url.Values{}
+	if start > 0 {
+		params.Set("start", fmt.Sprintf("%d", start))
+	}
+	if end > 0 {
+		params.Set("end", fmt.Sprintf("%d", end))
+	}
+
+	jsonLedgers := e.ApiKeyPost(strRequestPath, params, &Ledgers{})
+	if err := json.Unmarshal([]byte(jsonLedgers), &jsonResponse); err != nil {
+		return nil, fmt.Errorf("%s GetLedgers Json Unmarshal Err: %v %v", e.GetName(), err, jsonLedgers)
+	} else if len(jsonResponse.Error) != 0 {
+		return nil, fmt.Errorf("%s GetLedgers Failed: %v", e.GetName(), jsonResponse.Error)
+	}
+	if err := json.Unmarshal(jsonResponse.Result, &ledgers); err != nil {
+		return nil, fmt.Errorf("%s GetLedgers Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Result)
+	}
+
+	entries := []*LedgerEntry{}
+	for id, data := range ledgers.Ledger {
+		entry := &LedgerEntry{
+			ID:    id,
+			RefID: data.RefID,
+			Time:  data.Time,
+			Type:  data.Type,
+			Asset: data.Asset,
+			Coin:  e.GetCoinBySymbol(data.Asset),
+		}
+		entry.Amount, _ = strconv.ParseFloat(data.Amount, 64)
+		entry.Fee, _ = strconv.ParseFloat(data.Fee, 64)
+		entry.Balance, _ = strconv.ParseFloat(data.Balance, 64)
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Time < entries[j].Time })
+
+	return entries, nil
+}
+
 /*************** Signature Http Request ***************/
 /*Method: API Request and Signature is required
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
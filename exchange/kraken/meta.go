@@ -16,3 +16,36 @@ const (
 	DEFAULT_CONFIRMATION = 2
 	DEFAULT_LISTED       = true
 )
+
+/* Kraken altnames of coins whose standard code is different */
+var STANDARD_CODE = map[string]string{
+	"XBT": "BTC",
+	"XDG": "DOGE",
+}
+
+/* Kraken asset keys whose altname drops the legacy X/Z prefix, the other keys are their own altname */
+var ASSET_ALTNAMES = map[string]string{
+	"XETC": "ETC",
+	"XETH": "ETH",
+	"XICN": "ICN",
+	"XLTC": "LTC",
+	"XMLN": "MLN",
+	"XNMC": "NMC",
+	"XREP": "REP",
+	"XXBT": "XBT",
+	"XXDG": "XDG",
+	"XXLM": "XLM",
+	"XXMR": "XMR",
+	"XXRP": "XRP",
+	"XXVN": "XVN",
+	"XZEC": "ZEC",
+	"XDAO": "DAO",
+	"KFEE": "FEE",
+	"ZAUD": "AUD",
+	"ZCAD": "CAD",
+	"ZEUR": "EUR",
+	"ZGBP": "GBP",
+	"ZJPY": "JPY",
+	"ZKRW": "KRW",
+	"ZUSD": "USD",
+}
@@ -6,6 +6,8 @@ package kraken
 
 import (
 	"encoding/json"
+
+	"github.com/bitontop/gored/coin"
 )
 
 type JsonResponse struct {
@@ -38,6 +40,7 @@ type PairsData struct {
 	FeeVolumeCurrency string      `json:"fee_volume_currency"`
 	MarginCall        int         `json:"margin_call"`
 	MarginStop        int         `json:"margin_stop"`
+	Ordermin          string      `json:"ordermin"`
 }
 
 type OrderBook struct {
@@ -94,37 +97,35 @@ type CancelOrder struct {
 	Pending bool `json:"pending"`
 }
 
-/* type AccountBalances struct {
-	ADA  float64 `json:"ADA,string"`
-	ATOM float64 `json:"ATOM,string"`
-	BCH  float64 `json:"BCH,string"`
-	BSV  float64 `json:"BSV,string"`
-	DASH float64 `json:"DASH,string"`
-	EOS  float64 `json:"EOS,string"`
-	GNO  float64 `json:"GNO,string"`
-	KFEE float64 `json:"ADA,string"`
-	QTUM float64 `json:"QTUM,string"`
-	USDT float64 `json:"USDT,string"`
-	XDAO float64 `json:"XDAO,string"`
-	XETC float64 `json:"XETC,string"`
-	XETH float64 `json:"XETH,string"`
-	XICN float64 `json:"XICN,string"`
-	XLTC float64 `json:"XLTC,string"`
-	XMLN float64 `json:"XMLN,string"`
-	XNMC float64 `json:"XNMC,string"`
-	XREP float64 `json:"XREP,string"`
-	XTZ  float64 `json:"XTZ,string"`
-	XXBT float64 `json:"XXBT,string"`
-	XXDG float64 `json:"XXDG,string"`
-	XXLM float64 `json:"XXLM,string"`
-	XXMR float64 `json:"XXMR,string"`
-	XXRP float64 `json:"XXRP,string"`
-	XXVN float64 `json:"XXVN,string"`
-	XZEC float64 `json:"XZEC,string"`
-	ZCAD float64 `json:"ZCAD,string"`
-	ZEUR float64 `json:"ZEUR,string"`
-	ZGBP float64 `json:"ZGBP,string"`
-	ZJPY float64 `json:"ZJPY,string"`
-	ZKRW float64 `json:"ZKRW,string"`
-	ZUSD float64 `json:"ZUSD,string"`
-} */
+type OpenOrders struct {
+	Open map[string]Order `json:"open"`
+}
+
+type Ledgers struct {
+	Ledger map[string]LedgerData `json:"ledger"`
+	Count  int                   `json:"count"`
+}
+
+type LedgerData struct {
+	RefID   string  `json:"refid"`
+	Time    float64 `json:"time"`
+	Type    string  `json:"type"`
+	Subtype string  `json:"subtype"`
+	Aclass  string  `json:"aclass"`
+	Asset   string  `json:"asset"`
+	Amount  string  `json:"amount"`
+	Fee     string  `json:"fee"`
+	Balance string  `json:"balance"`
+}
+
+type LedgerEntry struct {
+	ID      string
+	RefID   string
+	Time    float64
+	Type    string
+	Asset   string
+	Coin    *coin.Coin
+	Amount  float64
+	Fee     float64
+	Balance float64
+}
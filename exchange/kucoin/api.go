@@ -18,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 /*The Base Endpoint URL*/
@@ -71,25 +72,27 @@ func (e *Kucoin) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Currency)
-			if c == nil {
-				c = &coin.Coin{}
-				c.Code = data.Currency
-				c.Name = data.FullName
-				coin.AddCoin(c)
-			}
+			// the API has the contract but not the chain it's on
+			c = exchange.GetOrAddCoin(e.GetName(), data.Currency, &coin.Coin{
+				Name:     data.FullName,
+				Contract: data.ContractAddress,
+			})
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Currency)
 		}
 
 		if c != nil {
 			txFee, _ := strconv.ParseFloat(data.WithdrawalMinFee, 64)
+			minWithdraw, _ := strconv.ParseFloat(data.WithdrawalMinSize, 64)
 			coinConstraint := &exchange.CoinConstraint{
 				CoinID:       c.ID,
 				Coin:         c,
 				ExSymbol:     data.Currency,
 				ChainType:    exchange.MAINNET,
+				Contract:     data.ContractAddress,
 				TxFee:        txFee,
+				MinWithdraw:  minWithdraw,
+				WithdrawStep: math.Pow10(-data.Precision),
 				Withdraw:     data.IsWithdrawEnabled,
 				Deposit:      data.IsDepositEnabled,
 				Confirmation: DEFAULT_CONFIRMATION,
@@ -126,8 +129,8 @@ func (e *Kucoin) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteCurrency)
-			target := coin.GetCoin(data.BaseCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -136,8 +139,11 @@ func (e *Kucoin) GetPairsData() error {
 		}
 
 		if p != nil {
-			lotSize, _ := strconv.ParseFloat(data.BaseIncrement, 64)
-			priceFilter, _ := strconv.ParseFloat(data.PriceIncrement, 64)
+			lotSize, _ := decimal.NewFromString(data.BaseIncrement)
+			priceFilter, _ := decimal.NewFromString(data.PriceIncrement)
+			minNotional, _ := decimal.NewFromString(data.QuoteMinSize)
+			minSize, _ := decimal.NewFromString(data.BaseMinSize)
+			maxSize, _ := decimal.NewFromString(data.BaseMaxSize)
 			pairConstraint := &exchange.PairConstraint{
 				PairID:      p.ID,
 				Pair:        p,
@@ -146,6 +152,9 @@ func (e *Kucoin) GetPairsData() error {
 				TakerFee:    DEFAULT_TAKER_FEE,
 				LotSize:     lotSize,
 				PriceFilter: priceFilter,
+				MinNotional: minNotional,
+				MinQuantity: minSize,
+				MaxQuantity: maxSize,
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -192,14 +201,14 @@ func (e *Kucoin) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 	for _, bid := range orderBook.Bids {
 		buydata := exchange.Order{}
 
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
-			log.Printf("%s OrderBook strconv.ParseFloat Rate error:%v", e.GetName(), err)
+			log.Printf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
-			log.Printf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			log.Printf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 			return nil, err
 		}
 		maker.Bids = append(maker.Bids, buydata)
@@ -207,13 +216,13 @@ func (e *Kucoin) OrderBook(p *pair.Pair) (*exchange.Maker, error) {
 
 	for i := len(orderBook.Asks) - 1; i >= 0; i-- {
 		selldata := exchange.Order{}
-		selldata.Rate, err = strconv.ParseFloat(orderBook.Asks[i][0], 64)
+		selldata.Rate, err = decimal.NewFromString(orderBook.Asks[i][0])
 		if err != nil {
-			log.Printf("%s OrderBook strconv.ParseFloat  Rate error:%v", e.GetName(), err)
+			log.Printf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
-		selldata.Quantity, err = strconv.ParseFloat(orderBook.Asks[i][1], 64)
+		selldata.Quantity, err = decimal.NewFromString(orderBook.Asks[i][1])
 		if err != nil {
-			log.Printf("%s OrderBook strconv.ParseFloat  Quantity error:%v", e.GetName(), err)
+			log.Printf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -253,7 +262,7 @@ func (e *Kucoin) UpdateAllBalances() {
 		if c != nil {
 			freeamount, err := strconv.ParseFloat(balance.Available, 64)
 			if err == nil {
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		}
 	}
@@ -304,10 +313,17 @@ func (e *Kucoin) UpdateAllBalances() {
 } */
 
 func (e *Kucoin) InnerTrans(quantity float64, coin *coin.Coin, fromType, toType, clientOid string) bool {
-	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
-		log.Printf("Kucoin API Key or Secret Key or passphrase are nil.")
+	if err := e.innerTransfer(quantity, coin, fromType, toType, clientOid); err != nil {
+		log.Printf("%v", err)
 		return false
 	}
+	return true
+}
+
+func (e *Kucoin) innerTransfer(quantity float64, coin *coin.Coin, fromType, toType, clientOid string) error {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return fmt.Errorf("Kucoin API Key or Secret Key or passphrase are nil.")
+	}
 
 	jsonResponse := JsonResponse{}
 	innerTrans := InnerTrans{}
@@ -315,27 +331,23 @@ func (e *Kucoin) InnerTrans(quantity float64, coin *coin.Coin, fromType, toType,
 
 	mapParams := make(map[string]string)
 	mapParams["clientOid"] = clientOid
-	mapParams["currency"] = coin.Code
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
 	mapParams["from"] = fromType
 	mapParams["to"] = toType
 	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64) //+ "0"
 
 	jsonCreateWithdraw := e.ApiKeyRequest("POST", strRequestUrl, mapParams)
 	if err := json.Unmarshal([]byte(jsonCreateWithdraw), &jsonResponse); err != nil {
-		log.Printf("%s InnerTrans Json Unmarshal Err: %v %v", e.GetName(), err, jsonCreateWithdraw)
-		return false
+		return fmt.Errorf("%s InnerTrans Json Unmarshal Err: %v %v", e.GetName(), err, jsonCreateWithdraw)
 	} else if jsonResponse.Code != "200000" {
-		log.Printf("%s InnerTrans Failed: %s %v", e.GetName(), jsonResponse.Code, jsonResponse.Msg)
-		return false
+		return fmt.Errorf("%s InnerTrans Failed: %s %v", e.GetName(), jsonResponse.Code, jsonResponse.Msg)
 	}
 
 	if err := json.Unmarshal(jsonResponse.Data, &innerTrans); err != nil {
-		log.Printf("%s InnerTrans Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
-		return false
+		return fmt.Errorf("%s InnerTrans Result Unmarshal Err: %v %s", e.GetName(), err, jsonResponse.Data)
 	}
 
-	// log.Printf("the withdraw state response %v and the withdraw id: %v", jsonCreateWithdraw, withdraw.WithdrawalID)
-	return true
+	return nil
 }
 
 /* Withdraw(coin *coin.Coin, quantity float64, addr, tag string) */
@@ -345,6 +357,11 @@ func (e *Kucoin) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) b
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	// need to use inner transfer before withdraw
 	// e.InnerTrans(quantity, coin, "trade", "main", fmt.Sprintf("%v", time.Now().UnixNano()/int64(time.Millisecond)))
 
@@ -380,20 +397,22 @@ func (e *Kucoin) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := OrderDetail{}
 	strRequest := "/api/v1/orders"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["clientOid"] = fmt.Sprintf("%v", time.Now().UnixNano()) //Unique order id selected by you to identify your order
 	mapParams["side"] = "sell"
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "limit"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["size"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["size"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -408,8 +427,8 @@ func (e *Kucoin) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -423,20 +442,22 @@ func (e *Kucoin) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil.", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := OrderDetail{}
 	strRequest := "/api/v1/orders"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["clientOid"] = fmt.Sprintf("%v", time.Now().UnixNano()) //Unique order id selected by you to identify your order
 	mapParams["side"] = "buy"
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "limit"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["size"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["size"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -451,8 +472,8 @@ func (e *Kucoin) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -483,9 +504,9 @@ func (e *Kucoin) OrderStatus(order *exchange.Order) error {
 	switch orderStatus.OpType {
 	case "DEAL":
 		dealSize, _ := strconv.ParseFloat(orderStatus.DealSize, 64)
-		if dealSize == order.Quantity {
+		if dealSize == order.Quantity.InexactFloat64() {
 			order.Status = exchange.Filled
-		} else if dealSize > 0 && dealSize < order.Quantity {
+		} else if dealSize > 0 && dealSize < order.Quantity.InexactFloat64() {
 			order.Status = exchange.Partial
 		} else if math.Abs(dealSize-0.0) < 0.00000000001 {
 			order.Status = exchange.New
@@ -537,6 +558,23 @@ func (e *Kucoin) CancelAllOrder() error {
 	return nil
 }
 
+/*************** Transfer API ***************/
+func (e *Kucoin) GetAccountTypes() []exchange.AccountType {
+	return []exchange.AccountType{exchange.SPOT, exchange.MARGIN, exchange.FUNDING}
+}
+
+/* InternalTransfer - deposits and withdrawals go through the main (FUNDING) account, trading uses the trade (SPOT) account */
+func (e *Kucoin) InternalTransfer(coin *coin.Coin, quantity float64, from, to exchange.AccountType) error {
+	fromType, okFrom := ACCOUNT_TYPE[from]
+	toType, okTo := ACCOUNT_TYPE[to]
+	if !okFrom || !okTo || from == to {
+		return fmt.Errorf("%s InternalTransfer from %s to %s is not supported", e.GetName(), from, to)
+	}
+
+	clientOid := fmt.Sprintf("%d", time.Now().UnixNano())
+	return e.innerTransfer(quantity, coin, fromType, toType, clientOid)
+}
+
 /*************** Signature Http Request ***************/
 /*Method: API Request and Signature is required
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
@@ -4,6 +4,8 @@ package kucoin
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
+import "github.com/bitontop/gored/exchange"
+
 const (
 	DEFAULT_ID           = 6
 	DEFAULT_TAKER_FEE    = 0.001
@@ -13,3 +15,10 @@ const (
 	DEFAULT_CONFIRMATION = 1001
 	DEFAULT_LISTED       = true
 )
+
+/* KuCoin account types used by the inner transfer */
+var ACCOUNT_TYPE = map[exchange.AccountType]string{
+	exchange.SPOT:    "trade",
+	exchange.MARGIN:  "margin",
+	exchange.FUNDING: "main",
+}
@@ -23,6 +23,7 @@ type CoinsData []struct {
 	WithdrawalMinSize string `json:"withdrawalMinSize"`
 	IsWithdrawEnabled bool   `json:"isWithdrawEnabled"`
 	IsDepositEnabled  bool   `json:"isDepositEnabled"`
+	ContractAddress   string `json:"contractAddress"`
 }
 
 type PairsData []struct {
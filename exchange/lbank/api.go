@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -28,6 +27,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -74,11 +74,11 @@ func (e *Lbank) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.AssetCode)
+			c = exchange.GetCoin(e.GetName(), data.AssetCode)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.AssetCode
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), data.AssetCode)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.AssetCode)
@@ -123,8 +123,8 @@ func (e *Lbank) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(coinStr[1])
-			target := coin.GetCoin(coinStr[0])
+			base := exchange.GetCoin(e.GetName(), coinStr[1])
+			target := exchange.GetCoin(e.GetName(), coinStr[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -140,8 +140,8 @@ func (e *Lbank) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(lotsize * -1),
-				PriceFilter: math.Pow10(ticksize * -1),
+				LotSize:     decimal.New(1, int32(lotsize*-1)),
+				PriceFilter: decimal.New(1, int32(ticksize*-1)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -184,16 +184,16 @@ func (e *Lbank) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate = bid[0]
-		buydata.Quantity = bid[1]
+		buydata.Rate = decimal.NewFromFloat(bid[0])
+		buydata.Quantity = decimal.NewFromFloat(bid[1])
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Asks {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate = ask[0]
-		selldata.Quantity = ask[1]
+		selldata.Rate = decimal.NewFromFloat(ask[0])
+		selldata.Quantity = decimal.NewFromFloat(ask[1])
 		maker.Asks = append(maker.Asks, selldata)
 	}
 	return maker, nil
@@ -226,7 +226,7 @@ func (e *Lbank) UpdateAllBalances() {
 			return
 		}
 		if c != nil {
-			balanceMap.Set(c.Code, freeamount)
+			e.balanceMap.Set(c.Code, freeamount)
 		}
 	}
 }
@@ -237,6 +237,11 @@ func (e *Lbank) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bo
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	withdraw := Withdraw{}
 	strRequest := "/v1/withdraw.do"
 
@@ -266,14 +271,19 @@ func (e *Lbank) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/create_order.do"
 
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "sell"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -285,8 +295,8 @@ func (e *Lbank) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -300,14 +310,19 @@ func (e *Lbank) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/v1/create_order.do"
 
 	mapParams := make(map[string]string)
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "buy"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -319,8 +334,8 @@ func (e *Lbank) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
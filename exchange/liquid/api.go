@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -19,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -65,11 +65,11 @@ func (e *Liquid) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Currency)
+			c = exchange.GetCoin(e.GetName(), data.Currency)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Currency
-				coin.AddCoin(c)
+				c.Code = exchange.GetCoinCode(e.GetName(), data.Currency)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Currency)
@@ -112,8 +112,8 @@ func (e *Liquid) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuotedCurrency)
-			target := coin.GetCoin(data.BaseCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuotedCurrency)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -129,8 +129,8 @@ func (e *Liquid) GetPairsData() error {
 				ExSymbol:    data.ID,
 				MakerFee:    makerfee,
 				TakerFee:    takerfee,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 				Listed:      !data.Disabled,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -168,11 +168,11 @@ func (e *Liquid) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
 			return nil, err
 		}
@@ -183,11 +183,11 @@ func (e *Liquid) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0], 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0])
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1], 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1])
 		if err != nil {
 			return nil, err
 		}
@@ -224,13 +224,52 @@ func (e *Liquid) UpdateAllBalances() {
 			if err != nil {
 				log.Printf("%s free balance parse Err: %v %v", e.GetName(), err, v.Balance)
 			}
-			balanceMap.Set(c.Code, available)
+			e.balanceMap.Set(c.Code, available)
 		}
 	}
 }
 
 func (e *Liquid) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-	return false
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		log.Printf("%s API Key or Secret Key are nil.", e.GetName())
+		return false
+	}
+
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
+	withdraw := WithdrawResponse{}
+	strRequest := "/crypto_withdrawals"
+
+	cryptoWithdrawal := make(map[string]interface{})
+	cryptoWithdrawal["currency"] = e.GetSymbolByCoin(coin)
+	cryptoWithdrawal["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	cryptoWithdrawal["address"] = addr
+	if tag != "" {
+		switch e.GetSymbolByCoin(coin) {
+		case "XRP":
+			cryptoWithdrawal["payment_id"] = tag
+		default: // XLM, EOS, etc.
+			cryptoWithdrawal["memo_type"] = "text"
+			cryptoWithdrawal["memo_value"] = tag
+		}
+	}
+
+	mapParams := make(map[string]interface{})
+	mapParams["crypto_withdrawal"] = cryptoWithdrawal
+
+	jsonSubmitWithdraw := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonSubmitWithdraw), &withdraw); err != nil {
+		log.Printf("%s Withdraw Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubmitWithdraw)
+		return false
+	} else if withdraw.ID == 0 {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), jsonSubmitWithdraw)
+		return false
+	}
+
+	return true
 }
 
 func (e *Liquid) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -238,18 +277,20 @@ func (e *Liquid) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/orders/"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]interface{})
 	mapParams["order_type"] = "limit"
 	mapParams["product_id"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "sell"
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["quantity"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -262,8 +303,8 @@ func (e *Liquid) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strconv.Itoa(placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -277,18 +318,20 @@ func (e *Liquid) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/orders/"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]interface{})
 	mapParams["order_type"] = "limit"
 	mapParams["product_id"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "buy"
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
+	mapParams["quantity"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -301,8 +344,8 @@ func (e *Liquid) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      strconv.Itoa(placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
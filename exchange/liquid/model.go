@@ -121,3 +121,12 @@ type CancelOrder struct {
 	ID     int    `json:"id"`
 	Status string `json:"status"`
 }
+
+type WithdrawResponse struct {
+	ID        int    `json:"id"`
+	Address   string `json:"address"`
+	Amount    string `json:"amount"`
+	State     string `json:"state"`
+	Currency  string `json:"currency"`
+	CreatedAt int64  `json:"created_at"`
+}
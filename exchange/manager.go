@@ -7,6 +7,7 @@ package exchange
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/bitontop/gored/pair"
 
 	cmap "github.com/orcaman/concurrent-map"
+	"github.com/shopspring/decimal"
 )
 
 type Exchange interface {
@@ -73,8 +75,47 @@ type Exchange interface {
 	GetConfirmation(coin *coin.Coin) int
 	/***** Pair Constraint *****/
 	GetFee(pair *pair.Pair) float64
-	GetLotSize(pair *pair.Pair) float64
-	GetPriceFilter(pair *pair.Pair) float64
+	GetLotSize(pair *pair.Pair) decimal.Decimal
+	GetPriceFilter(pair *pair.Pair) decimal.Decimal
+}
+
+// MarginExchange - spot margin trading, implemented by the adapters that support it.
+// The pair selects the margin account on exchanges with isolated margin (OKEX, Poloniex),
+// exchanges with a cross margin account (Binance, Huobi) ignore it.
+type MarginExchange interface {
+	Exchange
+
+	GetMarginBalances(pair *pair.Pair) ([]*MarginBalance, error)
+	GetMarginLevel(pair *pair.Pair) (float64, error)
+	GetMaxBorrowable(pair *pair.Pair, coin *coin.Coin) (float64, error)
+
+	Borrow(pair *pair.Pair, coin *coin.Coin, quantity float64) error
+	Repay(pair *pair.Pair, coin *coin.Coin, quantity float64) error
+
+	MarginLimitSell(pair *pair.Pair, quantity, rate float64) (*Order, error)
+	MarginLimitBuy(pair *pair.Pair, quantity, rate float64) (*Order, error)
+}
+
+// TransferExchange - moves funds between the accounts of the same user on the exchange,
+// GetAccountTypes lists the accounts InternalTransfer accepts.
+type TransferExchange interface {
+	Exchange
+
+	GetAccountTypes() []AccountType
+	InternalTransfer(coin *coin.Coin, quantity float64, from, to AccountType) error
+}
+
+// SubAccountExchange - sub-account management with the master account keys, the balances are keyed by coin code.
+// NewSubAccountInstance creates an adapter trading with the sub-account keys, sharing the exchange data.
+type SubAccountExchange interface {
+	Exchange
+
+	GetSubAccounts() ([]*SubAccount, error)
+	GetSubAccountBalances(sub *SubAccount) (map[string]float64, error)
+	TransferToSubAccount(sub *SubAccount, coin *coin.Coin, quantity float64) error
+	TransferFromSubAccount(sub *SubAccount, coin *coin.Coin, quantity float64) error
+
+	NewSubAccountInstance(config *Config) Exchange
 }
 
 type ExchangeManager struct {
@@ -85,6 +126,8 @@ var once sync.Once
 
 var exMap cmap.ConcurrentMap
 var exIDMap cmap.ConcurrentMap
+var accountMap cmap.ConcurrentMap
+var refreshingMap = cmap.New() // exchange name -> true while its data is refreshing
 var supportList = make([]ExchangeName, 0)
 
 func CreateExchangeManager() *ExchangeManager {
@@ -99,6 +142,10 @@ func CreateExchangeManager() *ExchangeManager {
 		if exIDMap == nil {
 			exIDMap = cmap.New()
 		}
+
+		if accountMap == nil {
+			accountMap = cmap.New()
+		}
 	})
 	return instance
 }
@@ -119,6 +166,45 @@ func (e *ExchangeManager) Add(exchange Exchange) {
 	}
 }
 
+// AddAccount - registers one account of the exchange by its label, the adapters only share the coin and pair data.
+// The first account added is also the exchange returned by Get and GetById.
+func (e *ExchangeManager) AddAccount(label string, exchange Exchange) {
+	if exMap.SetIfAbsent(string(exchange.GetName()), exchange) {
+		if !exIDMap.SetIfAbsent(fmt.Sprintf("%d", exchange.GetID()), exchange) {
+			log.Fatalf("%s ID: %d is exist. Please check.", exchange.GetName(), exchange.GetID())
+		}
+	}
+
+	if accountMap.Has(label) {
+		log.Printf("Account %s is exist, replaced by %s.", label, exchange.GetName())
+	}
+	accountMap.Set(label, exchange)
+}
+
+func (e *ExchangeManager) GetAccount(label string) Exchange {
+	if tmp, ok := accountMap.Get(label); ok {
+		return tmp.(Exchange)
+	}
+	return nil
+}
+
+func (e *ExchangeManager) GetAccountLabels() []string {
+	labels := accountMap.Keys()
+	sort.Strings(labels)
+	return labels
+}
+
+// GetAccounts - all the accounts registered for the exchange, sorted by label
+func (e *ExchangeManager) GetAccounts(name ExchangeName) []Exchange {
+	exchanges := []Exchange{}
+	for _, label := range e.GetAccountLabels() {
+		if ex := e.GetAccount(label); ex != nil && ex.GetName() == name {
+			exchanges = append(exchanges, ex)
+		}
+	}
+	return exchanges
+}
+
 func (e *ExchangeManager) Quantity() int {
 	return exMap.Count()
 }
@@ -189,16 +275,24 @@ func (e *ExchangeManager) SubsetPairs(e1, e2 Exchange) []*pair.Pair {
 func (e *ExchangeManager) UpdateExData(conf *Update) {
 	switch conf.Method {
 	case API_TIGGER:
+		addr := conf.Addr
+		if addr == "" {
+			addr = DEFAULT_CONTROL_ADDR
+		}
+		log.Printf("Control Server Listening on %s", addr)
+		if err := http.ListenAndServe(addr, e.NewControlHandler(conf.ExNames)); err != nil {
+			log.Printf("Control Server Err: %v", err)
+		}
 		break
 	case TIME_TIGGER:
 		for {
 			for _, exName := range conf.ExNames {
-				eInstance := e.Get(exName)
-				if eInstance != nil {
-					if err := eInstance.InitData(); err != nil {
-						log.Printf("Updating %s Data is failed.", exName)
+				if e.Get(exName) != nil {
+					result := e.refreshExchange(exName)
+					if !result.Success {
+						log.Printf("Updating %s Data is failed: %s", exName, result.Error)
 					} else {
-						log.Printf("%s Data Updated. Coin: %d   Pair: %d", exName, len(eInstance.GetCoins()), len(eInstance.GetPairs()))
+						log.Printf("%s Data Updated. Coin: %d   Pair: %d", exName, result.Coins, result.Pairs)
 					}
 				}
 			}
@@ -206,3 +300,46 @@ func (e *ExchangeManager) UpdateExData(conf *Update) {
 		}
 	}
 }
+
+// RefreshExData - reloads the coins and pairs of the exchanges at the same time, one result for each in order,
+// an exchange already refreshing is refused instead of refreshed twice
+func (e *ExchangeManager) RefreshExData(exNames []ExchangeName) []*RefreshResult {
+	results := make([]*RefreshResult, len(exNames))
+	var wg sync.WaitGroup
+	for i, exName := range exNames {
+		wg.Add(1)
+		go func(i int, exName ExchangeName) {
+			defer wg.Done()
+			results[i] = e.refreshExchange(exName)
+		}(i, exName)
+	}
+	wg.Wait()
+	return results
+}
+
+func (e *ExchangeManager) refreshExchange(exName ExchangeName) *RefreshResult {
+	result := &RefreshResult{ExName: exName}
+	eInstance := e.Get(exName)
+	if eInstance == nil {
+		result.Error = fmt.Sprintf("%s is not added", exName)
+		return result
+	}
+	if !refreshingMap.SetIfAbsent(string(exName), true) {
+		result.Refused = true
+		result.Error = fmt.Sprintf("%s is refreshing already", exName)
+		return result
+	}
+	defer refreshingMap.Remove(string(exName))
+
+	start := time.Now()
+	err := eInstance.InitData()
+	result.Duration = time.Since(start)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Success = true
+	result.Coins = len(eInstance.GetCoins())
+	result.Pairs = len(eInstance.GetPairs())
+	return result
+}
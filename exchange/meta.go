@@ -8,11 +8,16 @@ type ExchangeName string
 type DataSource string
 type ChainType string
 type UpdateMethod string
+type AccountType string
 
 const (
 	API_TIGGER  UpdateMethod = "API_TIGGER"
 	TIME_TIGGER UpdateMethod = "TIME_TIGGER"
 
+	// the API_TIGGER control server address when Update.Addr is empty, the endpoint has no authentication
+	// so it isn't served on all interfaces by default
+	DEFAULT_CONTROL_ADDR = "localhost:8081"
+
 	EXCHANGE_API     DataSource = "EXCHANGE_API"
 	MICROSERVICE_API DataSource = "MICROSERVICE_API"
 	JSON_FILE        DataSource = "JSON_FILE"
@@ -25,6 +30,12 @@ const (
 	OMNI    ChainType = "OMNI"
 	TRC20   ChainType = "TRC20"
 
+	SPOT    AccountType = "SPOT"
+	MARGIN  AccountType = "MARGIN"
+	FUTURES AccountType = "FUTURES"
+	SWAP    AccountType = "SWAP"
+	FUNDING AccountType = "FUNDING" // the main/funding account for deposit and withdraw, eg: KuCoin main, OKEX funding
+
 	BCEX         ExchangeName = "BCEX"
 	BGOGO        ExchangeName = "BGOGO"
 	BIBOX        ExchangeName = "BIBOX"
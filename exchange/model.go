@@ -5,22 +5,39 @@ package exchange
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
+	"io/fs"
 	"time"
 
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/pair"
+
+	"github.com/shopspring/decimal"
 )
 
 type Update struct {
 	ExNames []ExchangeName
 	Method  UpdateMethod
 	Time    time.Duration
+	Addr    string // the control server address of API_TIGGER, DEFAULT_CONTROL_ADDR if empty, it has no authentication
+}
+
+// RefreshResult - the outcome of refreshing the coins and pairs of an exchange, Duration is in nanoseconds in JSON
+type RefreshResult struct {
+	ExName   ExchangeName  `json:"exchange"`
+	Success  bool          `json:"success"`
+	Refused  bool          `json:"refused,omitempty"` // the exchange was refreshing already
+	Error    string        `json:"error,omitempty"`
+	Coins    int           `json:"coins"`
+	Pairs    int           `json:"pairs"`
+	Duration time.Duration `json:"duration"`
 }
 
 type Config struct {
 	ExName        ExchangeName
+	Account       string // the account label, several accounts of the same exchange are registered by label
 	Source        DataSource
 	SourceURI     string
+	SourceFS      fs.FS // JSON_FILE reads the files from it instead of SourceURI if set, eg: os.DirFS("./data")
 	Account_ID    string
 	API_KEY       string
 	API_SECRET    string
@@ -31,16 +48,21 @@ type Config struct {
 }
 
 type PairConstraint struct {
-	PairID      int
-	Pair        *pair.Pair //the code on excahnge with the same chain, eg: BCH, BCC on different exchange, but they are the same chain
-	ExID        string
-	ExSymbol    string
-	MakerFee    float64
-	TakerFee    float64
-	LotSize     float64 // the decimal place for this coin on exchange for the pairs, eg:  BTC: 0.00001    NEO:1   LTC: 0.001 ETH:0.01
-	PriceFilter float64
-	Listed      bool
-	Issue       string //the issue for the pair if have any problem
+	PairID            int
+	Pair              *pair.Pair //the code on excahnge with the same chain, eg: BCH, BCC on different exchange, but they are the same chain
+	ExID              string
+	ExSymbol          string
+	MakerFee          float64
+	TakerFee          float64
+	LotSize           decimal.Decimal // the quantity step for this coin on exchange for the pairs, eg:  BTC: 0.00001    NEO:1   LTC: 0.001 ETH:0.01
+	PriceFilter       decimal.Decimal // the price tick, not always a power of 10, eg: 0.5 or 0.25
+	MinNotional       decimal.Decimal // the minimum order value counted in the base coin, 0 if the exchange doesn't limit it
+	MinQuantity       decimal.Decimal // the minimum order quantity counted in the target coin, 0 if the exchange doesn't limit it
+	MaxQuantity       decimal.Decimal // the maximum order quantity counted in the target coin, 0 if the exchange doesn't limit it
+	MarketMinQuantity decimal.Decimal // the quantity limits of market orders, 0 if they are the same as limit orders'
+	MarketMaxQuantity decimal.Decimal
+	Listed            bool
+	Issue             string //the issue for the pair if have any problem
 }
 
 type CoinConstraint struct {
@@ -48,7 +70,11 @@ type CoinConstraint struct {
 	Coin         *coin.Coin
 	ExSymbol     string
 	ChainType    ChainType
+	Contract     string  // the token contract address on the chain, empty for the coin of the chain
 	TxFee        float64 // the withdraw fee for this exchange
+	MinWithdraw  float64 // the minimum withdraw quantity, 0 if the exchange doesn't limit it
+	WithdrawStep float64 // the withdraw quantity is a multiple of it, 0 if the exchange doesn't limit it
+	MinDeposit   float64 // deposits below it are not credited, 0 if the exchange doesn't limit it
 	Withdraw     bool
 	Deposit      bool
 	Confirmation int
@@ -88,8 +114,8 @@ type Order struct {
 	Pair          *pair.Pair
 	OrderID       string
 	FilledOrders  []int64
-	Rate          float64 `bson:"Rate"`
-	Quantity      float64 `bson:"Quantity"`
+	Rate          decimal.Decimal `bson:"Rate"`
+	Quantity      decimal.Decimal `bson:"Quantity"`
 	Side          string
 	Status        OrderStatus `json:"status"`
 	StatusMessage string
@@ -101,6 +127,20 @@ type Order struct {
 	CancelStatus string
 }
 
+type MarginBalance struct {
+	Pair     *pair.Pair // the isolated margin account, nil for cross margin
+	Coin     *coin.Coin
+	Free     float64
+	Locked   float64
+	Borrowed float64
+	Interest float64
+}
+
+type SubAccount struct {
+	ID     string // the sub account on the exchange, eg: email on Binance, uid on Huobi, name on OKEX
+	Frozen bool
+}
+
 type Maker struct {
 	WorkerIP        string  `bson:"workerip"`
 	BeforeTimestamp float64 `bson:"beforetimestamp"`
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -72,17 +72,17 @@ func (e *Mxc) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(symbols[1])
+			base = exchange.GetCoin(e.GetName(), symbols[1])
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = symbols[1]
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), symbols[1])
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(symbols[0])
+			target = exchange.GetCoin(e.GetName(), symbols[0])
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = symbols[0]
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), symbols[0])
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(symbols[1])
@@ -148,8 +148,8 @@ func (e *Mxc) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(symbols[1])
-			target := coin.GetCoin(symbols[0])
+			base := exchange.GetCoin(e.GetName(), symbols[1])
+			target := exchange.GetCoin(e.GetName(), symbols[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -163,8 +163,8 @@ func (e *Mxc) GetPairsData() error {
 				ExSymbol:    key,
 				MakerFee:    data.SellFeeRate,
 				TakerFee:    data.BuyFeeRate,
-				LotSize:     math.Pow10(-1 * data.QuantityScale),
-				PriceFilter: math.Pow10(-1 * data.PriceScale),
+				LotSize:     decimal.New(1, int32(-1*data.QuantityScale)),
+				PriceFilter: decimal.New(1, int32(-1*data.PriceScale)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -212,11 +212,11 @@ func (e *Mxc) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Quantity, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Quantity)
 		if err != nil {
 			return nil, err
 		}
@@ -227,11 +227,11 @@ func (e *Mxc) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Quantity, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Quantity)
 		if err != nil {
 			return nil, err
 		}
@@ -273,13 +273,14 @@ func (e *Mxc) UpdateAllBalances() {
 				log.Printf("%s balance parse Err: %v %v", e.GetName(), err, v.Available)
 				return
 			}
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
 
+// Withdraw - not viable, the v1 open API only has account info and order endpoints, there is no withdraw endpoint
 func (e *Mxc) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -288,18 +289,20 @@ func (e *Mxc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/open/api/v1/private/order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["market"] = e.GetSymbolByPair(pair)
 	mapParams["trade_type"] = "2"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -314,8 +317,8 @@ func (e *Mxc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprint(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -325,18 +328,20 @@ func (e *Mxc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 }
 
 func (e *Mxc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/open/api/v1/private/order"
 
-	priceFilter := int(math.Round(math.Log10(e.GetPriceFilter(pair)) * -1))
-	lotSize := int(math.Round(math.Log10(e.GetLotSize(pair)) * -1))
-
 	mapParams := make(map[string]string)
 	mapParams["market"] = e.GetSymbolByPair(pair)
 	mapParams["trade_type"] = "1"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', priceFilter, 64)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', lotSize, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["quantity"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -351,8 +356,8 @@ func (e *Mxc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprint(placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -12,11 +12,13 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -65,12 +67,12 @@ func (e *Okex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Currency) //data.Currency)
+			c = exchange.GetCoin(e.GetName(), data.Currency) //data.Currency)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Currency //data.Currency
+				c.Code = exchange.GetCoinCode(e.GetName(), data.Currency) //data.Currency
 				c.Name = data.Name
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 
 		case exchange.JSON_FILE:
@@ -85,6 +87,7 @@ func (e *Okex) GetCoinsData() error {
 				ChainType:    exchange.MAINNET,
 				Confirmation: DEFAULT_CONFIRMATION,
 			}
+			coinConstraint.MinWithdraw, _ = strconv.ParseFloat(data.MinWithdrawal, 64)
 
 			if data.CanDeposit == "1" {
 				coinConstraint.Deposit = true
@@ -158,8 +161,8 @@ func (e *Okex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteCurrency)
-			target := coin.GetCoin(data.BaseCurrency)
+			base := exchange.GetCoin(e.GetName(), data.QuoteCurrency)
+			target := exchange.GetCoin(e.GetName(), data.BaseCurrency)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -167,14 +170,15 @@ func (e *Okex) GetPairsData() error {
 			p = e.GetPairBySymbol(data.InstrumentID)
 		}
 
-		lotSize, err := strconv.ParseFloat(data.SizeIncrement, 64)
+		lotSize, err := decimal.NewFromString(data.SizeIncrement)
 		if err != nil {
-			return fmt.Errorf("%s Convert lotSize to Float64 Err: %v %v", e.GetName(), err, data.SizeIncrement)
+			return fmt.Errorf("%s Convert lotSize to Decimal Err: %v %v", e.GetName(), err, data.SizeIncrement)
 		}
-		priceFilter, err := strconv.ParseFloat(data.TickSize, 64)
+		priceFilter, err := decimal.NewFromString(data.TickSize)
 		if err != nil {
-			return fmt.Errorf("%s Convert lotSize to Float64 Err: %v %v", e.GetName(), err, data.TickSize)
+			return fmt.Errorf("%s Convert tickSize to Decimal Err: %v %v", e.GetName(), err, data.TickSize)
 		}
+		minSize, _ := decimal.NewFromString(data.MinSize)
 
 		if p != nil {
 			pairConstraint := &exchange.PairConstraint{
@@ -185,6 +189,7 @@ func (e *Okex) GetPairsData() error {
 				TakerFee:    DEFAULT_TAKER_FEE,
 				LotSize:     lotSize,
 				PriceFilter: priceFilter,
+				MinQuantity: minSize,
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -219,15 +224,15 @@ func (e *Okex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	maker.AfterTimestamp = float64(time.Now().UnixNano() / 1e6)
 	for _, bid := range orderBook.Bids {
 		var buydata exchange.Order
-		buydata.Rate, _ = strconv.ParseFloat(bid[0], 64)
-		buydata.Quantity, _ = strconv.ParseFloat(bid[1], 64)
+		buydata.Rate, _ = decimal.NewFromString(bid[0])
+		buydata.Quantity, _ = decimal.NewFromString(bid[1])
 
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Asks {
 		var selldata exchange.Order
-		selldata.Rate, _ = strconv.ParseFloat(ask[0], 64)
-		selldata.Quantity, _ = strconv.ParseFloat(ask[1], 64)
+		selldata.Rate, _ = decimal.NewFromString(ask[0])
+		selldata.Quantity, _ = decimal.NewFromString(ask[1])
 
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -266,7 +271,7 @@ func (e *Okex) UpdateAllBalances() {
 				log.Printf("%s available balance conver to float64 err : %v", e.GetName, err)
 				balanceAvailable = 0.0
 			}
-			balanceMap.Set(c.Code, balanceAvailable)
+			e.balanceMap.Set(c.Code, balanceAvailable)
 		}
 	}
 }
@@ -277,6 +282,11 @@ func (e *Okex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) boo
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	withdrawResponse := WithdrawResponse{}
 	strRequest := "/api/account/v3/withdrawal"
 
@@ -301,10 +311,17 @@ func (e *Okex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) boo
 }
 
 func (e *Okex) Transfer(coin *coin.Coin, quantity float64, from, to int) bool {
-	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
-		log.Printf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	if err := e.transfer(coin, quantity, from, to); err != nil {
+		log.Printf("%v", err)
 		return false
 	}
+	return true
+}
+
+func (e *Okex) transfer(coin *coin.Coin, quantity float64, from, to int) error {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
 
 	transfer := Transfer{}
 	strRequest := "/api/account/v3/transfer"
@@ -318,14 +335,12 @@ func (e *Okex) Transfer(coin *coin.Coin, quantity float64, from, to int) bool {
 	jsonTransfer := e.ApiKeyRequest("POST", mapParams, strRequest)
 
 	if err := json.Unmarshal([]byte(jsonTransfer), &transfer); err != nil {
-		log.Printf("%s Transfer Unmarshal Err: %v %v", e.GetName, err, jsonTransfer)
-		return false
+		return fmt.Errorf("%s Transfer Unmarshal Err: %v %v", e.GetName(), err, jsonTransfer)
 	} else if !transfer.Result {
-		log.Printf("%s Transfer Failed: %v %v", e.GetName, transfer.Code, transfer.Message)
-		return false
+		return fmt.Errorf("%s Transfer Failed: %v %v", e.GetName(), transfer.Code, transfer.Message)
 	}
 
-	return true
+	return nil
 }
 
 func (e *Okex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
@@ -333,6 +348,11 @@ func (e *Okex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/spot/v3/orders"
 
@@ -340,8 +360,8 @@ func (e *Okex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	mapParams["side"] = "sell"
 	mapParams["instrument_id"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "limit"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["size"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["size"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -353,8 +373,8 @@ func (e *Okex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -368,6 +388,11 @@ func (e *Okex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/spot/v3/orders"
 
@@ -375,8 +400,8 @@ func (e *Okex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	mapParams["side"] = "buy"
 	mapParams["instrument_id"] = e.GetSymbolByPair(pair)
 	mapParams["type"] = "limit"
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["size"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["price"] = orderRate.String()
+	mapParams["size"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -388,8 +413,8 @@ func (e *Okex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -470,6 +495,303 @@ func (e *Okex) CancelAllOrder() error {
 	return nil
 }
 
+/*************** Margin API ***************/
+/* OKEX margin accounts are isolated, every instrument has its own account */
+func (e *Okex) getMarginAccount(pair *pair.Pair) (map[string]json.RawMessage, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return nil, fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
+
+	marginAccount := make(map[string]json.RawMessage)
+	strRequest := fmt.Sprintf("/api/margin/v3/accounts/%s", e.GetSymbolByPair(pair))
+
+	jsonMarginReturn := e.ApiKeyRequest("GET", nil, strRequest)
+	if err := json.Unmarshal([]byte(jsonMarginReturn), &marginAccount); err != nil {
+		return nil, fmt.Errorf("%s Get Margin Account Json Unmarshal Err: %v %v", e.GetName(), err, jsonMarginReturn)
+	} else if _, ok := marginAccount["code"]; ok {
+		errorJson := ErrorMsg{}
+		json.Unmarshal([]byte(jsonMarginReturn), &errorJson)
+		return nil, fmt.Errorf("%s Get Margin Account Failed: %v %v", e.GetName(), errorJson.Code, errorJson.Msg)
+	}
+
+	return marginAccount, nil
+}
+
+func (e *Okex) GetMarginBalances(pair *pair.Pair) ([]*exchange.MarginBalance, error) {
+	marginAccount, err := e.getMarginAccount(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := []*exchange.MarginBalance{}
+	for key, data := range marginAccount {
+		if !strings.HasPrefix(key, "currency:") {
+			continue
+		}
+		c := e.GetCoinBySymbol(strings.TrimPrefix(key, "currency:"))
+		if c == nil {
+			continue
+		}
+		marginCurrency := MarginCurrency{}
+		if err := json.Unmarshal(data, &marginCurrency); err != nil {
+			return nil, fmt.Errorf("%s GetMarginBalances Json Unmarshal Err: %v %s", e.GetName(), err, data)
+		}
+		balance := &exchange.MarginBalance{Pair: pair, Coin: c}
+		balance.Free, _ = strconv.ParseFloat(marginCurrency.Available, 64)
+		balance.Locked, _ = strconv.ParseFloat(marginCurrency.Hold, 64)
+		balance.Borrowed, _ = strconv.ParseFloat(marginCurrency.Borrowed, 64)
+		balance.Interest, _ = strconv.ParseFloat(marginCurrency.LendingFee, 64)
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+/* GetMarginLevel - margin ratio of the instrument margin account */
+func (e *Okex) GetMarginLevel(pair *pair.Pair) (float64, error) {
+	marginAccount, err := e.getMarginAccount(pair)
+	if err != nil {
+		return 0, err
+	}
+
+	marginRatio := ""
+	if err := json.Unmarshal(marginAccount["margin_ratio"], &marginRatio); err != nil {
+		return 0, fmt.Errorf("%s GetMarginLevel Json Unmarshal Err: %v", e.GetName(), err)
+	}
+
+	return strconv.ParseFloat(marginRatio, 64)
+}
+
+func (e *Okex) GetMaxBorrowable(pair *pair.Pair, coin *coin.Coin) (float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return 0, fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
+
+	availability := MarginAvailability{}
+	strRequest := fmt.Sprintf("/api/margin/v3/accounts/%s/availability", e.GetSymbolByPair(pair))
+
+	jsonAvailability := e.ApiKeyRequest("GET", nil, strRequest)
+	if err := json.Unmarshal([]byte(jsonAvailability), &availability); err != nil {
+		errorJson := ErrorMsg{}
+		if err := json.Unmarshal([]byte(jsonAvailability), &errorJson); err == nil && errorJson.Code != 0 {
+			return 0, fmt.Errorf("%s GetMaxBorrowable Failed: %v %v", e.GetName(), errorJson.Code, errorJson.Msg)
+		}
+		return 0, fmt.Errorf("%s GetMaxBorrowable Json Unmarshal Err: %v %v", e.GetName(), err, jsonAvailability)
+	}
+
+	key := fmt.Sprintf("currency:%s", e.GetSymbolByCoin(coin))
+	for _, instrument := range availability {
+		if data, ok := instrument[key]; ok {
+			marginCurrency := MarginCurrency{}
+			if err := json.Unmarshal(data, &marginCurrency); err != nil {
+				return 0, fmt.Errorf("%s GetMaxBorrowable Json Unmarshal Err: %v %s", e.GetName(), err, data)
+			}
+			return strconv.ParseFloat(marginCurrency.Available, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("%s GetMaxBorrowable %s not found: %v", e.GetName(), key, jsonAvailability)
+}
+
+func (e *Okex) Borrow(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	return e.marginTransaction("/api/margin/v3/accounts/borrow", pair, coin, quantity)
+}
+
+func (e *Okex) Repay(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	return e.marginTransaction("/api/margin/v3/accounts/repayment", pair, coin, quantity)
+}
+
+func (e *Okex) marginTransaction(strRequest string, pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
+
+	transaction := MarginTransaction{}
+
+	mapParams := make(map[string]interface{})
+	mapParams["instrument_id"] = e.GetSymbolByPair(pair)
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonTransaction := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransaction), &transaction); err != nil {
+		return fmt.Errorf("%s %s Json Unmarshal Err: %v %v", e.GetName(), strRequest, err, jsonTransaction)
+	} else if !transaction.Result {
+		return fmt.Errorf("%s %s Failed: %v %v", e.GetName(), strRequest, transaction.Code, transaction.Message)
+	}
+
+	return nil
+}
+
+func (e *Okex) MarginLimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "sell", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitSell %v", e.GetName(), err)
+	}
+	order.Side = "Sell"
+	return order, nil
+}
+
+func (e *Okex) MarginLimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "buy", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitBuy %v", e.GetName(), err)
+	}
+	order.Side = "Buy"
+	return order, nil
+}
+
+func (e *Okex) placeMarginOrder(pair *pair.Pair, side string, quantity, rate float64) (*exchange.Order, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return nil, fmt.Errorf("API Key, Secret Key or Passphrase are nil")
+	}
+
+	placeOrder := PlaceOrder{}
+	strRequest := "/api/margin/v3/orders"
+
+	mapParams := make(map[string]interface{})
+	mapParams["side"] = side
+	mapParams["instrument_id"] = e.GetSymbolByPair(pair)
+	mapParams["type"] = "limit"
+	mapParams["margin_trading"] = "2"
+	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["size"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonPlaceReturn := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
+		return nil, fmt.Errorf("Json Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	} else if !placeOrder.Result {
+		return nil, fmt.Errorf("Failed: %v %v", placeOrder.Code, placeOrder.Message)
+	}
+
+	order := &exchange.Order{
+		Pair:         pair,
+		OrderID:      placeOrder.OrderID,
+		Rate:         decimal.NewFromFloat(rate),
+		Quantity:     decimal.NewFromFloat(quantity),
+		Status:       exchange.New,
+		JsonResponse: jsonPlaceReturn,
+	}
+	return order, nil
+}
+
+/*************** Transfer API ***************/
+func (e *Okex) GetAccountTypes() []exchange.AccountType {
+	return []exchange.AccountType{exchange.SPOT, exchange.FUTURES, exchange.SWAP, exchange.FUNDING}
+}
+
+/* InternalTransfer - the margin accounts are per instrument, so MARGIN is not listed */
+func (e *Okex) InternalTransfer(coin *coin.Coin, quantity float64, from, to exchange.AccountType) error {
+	fromAccount, okFrom := ACCOUNT_TYPE[from]
+	toAccount, okTo := ACCOUNT_TYPE[to]
+	if !okFrom || !okTo || from == to {
+		return fmt.Errorf("%s InternalTransfer from %s to %s is not supported", e.GetName(), from, to)
+	}
+
+	return e.transfer(coin, quantity, fromAccount, toAccount)
+}
+
+/*************** Sub Account API ***************/
+/* Sub-accounts are identified by name, v3 has no listing so GetSubAccounts calls the v5 endpoint */
+func (e *Okex) GetSubAccounts() ([]*exchange.SubAccount, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return nil, fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
+
+	subAccountList := SubAccountList{}
+	strRequest := "/api/v5/users/subaccount/list"
+
+	jsonSubAccountReturn := e.ApiKeyRequest("GET", nil, strRequest)
+	if err := json.Unmarshal([]byte(jsonSubAccountReturn), &subAccountList); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccounts Json Unmarshal Err: %v %v", e.GetName(), err, jsonSubAccountReturn)
+	} else if subAccountList.Code != "0" {
+		return nil, fmt.Errorf("%s GetSubAccounts Failed: %v %v", e.GetName(), subAccountList.Code, subAccountList.Msg)
+	}
+
+	subAccounts := []*exchange.SubAccount{}
+	for _, sub := range subAccountList.Data {
+		subAccounts = append(subAccounts, &exchange.SubAccount{
+			ID:     sub.SubAcct,
+			Frozen: !sub.Enable,
+		})
+	}
+	return subAccounts, nil
+}
+
+func (e *Okex) GetSubAccountBalances(sub *exchange.SubAccount) (map[string]float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return nil, fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
+
+	subAccountDetail := SubAccountDetail{}
+	spotBalances := AccountBalances{}
+	strRequest := "/api/account/v3/sub-account"
+
+	mapParams := make(map[string]string)
+	mapParams["sub-account"] = sub.ID
+
+	strRequest += fmt.Sprintf("?%s", exchange.Map2UrlQuery(mapParams))
+
+	jsonDetailReturn := e.ApiKeyRequest("GET", nil, strRequest)
+	if err := json.Unmarshal([]byte(jsonDetailReturn), &subAccountDetail); err != nil {
+		return nil, fmt.Errorf("%s GetSubAccountBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonDetailReturn)
+	} else if subAccountDetail.Data == nil {
+		return nil, fmt.Errorf("%s GetSubAccountBalances Failed: %v", e.GetName(), jsonDetailReturn)
+	}
+	if data, ok := subAccountDetail.Data["account_type:spot"]; ok {
+		if err := json.Unmarshal(data, &spotBalances); err != nil {
+			return nil, fmt.Errorf("%s GetSubAccountBalances Spot Unmarshal Err: %v %s", e.GetName(), err, data)
+		}
+	}
+
+	balances := make(map[string]float64)
+	for _, v := range spotBalances {
+		c := e.GetCoinBySymbol(v.Currency)
+		if c == nil {
+			continue
+		}
+		if balanceAvailable, err := strconv.ParseFloat(v.Available, 64); err == nil {
+			balances[c.Code] = balanceAvailable
+		}
+	}
+	return balances, nil
+}
+
+func (e *Okex) TransferToSubAccount(sub *exchange.SubAccount, coin *coin.Coin, quantity float64) error {
+	return e.subAccountTransfer(sub, coin, quantity, "1")
+}
+
+func (e *Okex) TransferFromSubAccount(sub *exchange.SubAccount, coin *coin.Coin, quantity float64) error {
+	return e.subAccountTransfer(sub, coin, quantity, "2")
+}
+
+/* subAccountTransfer - type 1: master to sub-account, 2: sub-account to master, between the spot accounts */
+func (e *Okex) subAccountTransfer(sub *exchange.SubAccount, coin *coin.Coin, quantity float64, transferType string) error {
+	if e.API_KEY == "" || e.API_SECRET == "" || e.Passphrase == "" {
+		return fmt.Errorf("%s API Key, Secret Key or Passphrase are nil", e.GetName())
+	}
+
+	transfer := Transfer{}
+	strRequest := "/api/account/v3/transfer"
+
+	mapParams := make(map[string]interface{})
+	mapParams["currency"] = e.GetSymbolByCoin(coin)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["from"] = ACCOUNT_TYPE[exchange.SPOT]
+	mapParams["to"] = ACCOUNT_TYPE[exchange.SPOT]
+	mapParams["type"] = transferType
+	mapParams["sub_account"] = sub.ID
+
+	jsonTransfer := e.ApiKeyRequest("POST", mapParams, strRequest)
+	if err := json.Unmarshal([]byte(jsonTransfer), &transfer); err != nil {
+		return fmt.Errorf("%s SubAccount Transfer Unmarshal Err: %v %v", e.GetName(), err, jsonTransfer)
+	} else if !transfer.Result {
+		return fmt.Errorf("%s SubAccount Transfer Failed: %v %v", e.GetName(), transfer.Code, transfer.Message)
+	}
+
+	return nil
+}
+
 /*************** Signature Http Request ***************/
 /*Method: API Request and Signature is required
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
@@ -4,6 +4,8 @@ package okex
 // Distributed under the MIT software license, see the accompanying
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
+import "github.com/bitontop/gored/exchange"
+
 const (
 	DEFAULT_ID           = 13
 	DEFAULT_TAKER_FEE    = 0.0015
@@ -13,3 +15,11 @@ const (
 	DEFAULT_CONFIRMATION = 2
 	DEFAULT_LISTED       = true
 )
+
+/* OKEX v3 account codes used by the funds transfer */
+var ACCOUNT_TYPE = map[exchange.AccountType]int{
+	exchange.SPOT:    1,
+	exchange.FUTURES: 3,
+	exchange.FUNDING: 6,
+	exchange.SWAP:    9,
+}
@@ -5,6 +5,7 @@ package okex
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
+	"encoding/json"
 	"time"
 )
 
@@ -95,3 +96,38 @@ type OrderStatus struct {
 	Code           int       `json:"code"`
 	Message        string    `json:"message"`
 }
+
+type MarginCurrency struct {
+	Available  string `json:"available"`
+	Balance    string `json:"balance"`
+	Borrowed   string `json:"borrowed"`
+	Frozen     string `json:"frozen"`
+	Hold       string `json:"hold"`
+	LendingFee string `json:"lending_fee"`
+}
+
+// MarginAvailability - keyed by "currency:<symbol>" and "instrument_id"
+type MarginAvailability []map[string]json.RawMessage
+
+type MarginTransaction struct {
+	BorrowID    string `json:"borrow_id"`
+	RepaymentID string `json:"repayment_id"`
+	Result      bool   `json:"result"`
+	Code        int    `json:"code"`
+	Message     string `json:"message"`
+}
+
+type SubAccountList struct {
+	Code string `json:"code"`
+	Msg  string `json:"msg"`
+	Data []struct {
+		SubAcct string `json:"subAcct"`
+		Label   string `json:"label"`
+		Enable  bool   `json:"enable"`
+	} `json:"data"`
+}
+
+// SubAccountDetail - Data is keyed by "account_type:<spot|futures|...>", "sub_account" and "asset_valuation"
+type SubAccountDetail struct {
+	Data map[string]json.RawMessage `json:"data"`
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -70,17 +71,17 @@ func (e *Otcbtc) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(coinStrs[1])
+			base = exchange.GetCoin(e.GetName(), coinStrs[1])
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = coinStrs[1]
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), coinStrs[1])
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(coinStrs[0])
+			target = exchange.GetCoin(e.GetName(), coinStrs[0])
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = coinStrs[0]
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), coinStrs[0])
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(coinStrs[1])
@@ -146,8 +147,8 @@ func (e *Otcbtc) GetPairsData() error {
 
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(pairStrs[1])
-			target := coin.GetCoin(pairStrs[0])
+			base := exchange.GetCoin(e.GetName(), pairStrs[1])
+			target := exchange.GetCoin(e.GetName(), pairStrs[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -161,8 +162,8 @@ func (e *Otcbtc) GetPairsData() error {
 				ExSymbol:    data.ID,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     data.TradingRule.MinAmount,
-				PriceFilter: data.TradingRule.MinPrice,
+				LotSize:     decimal.NewFromFloat(data.TradingRule.MinAmount),
+				PriceFilter: decimal.NewFromFloat(data.TradingRule.MinPrice),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -210,11 +211,11 @@ func (e *Otcbtc) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		buydata := exchange.Order{}
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0], 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1], 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1])
 		if err != nil {
 			return nil, err
 		}
@@ -225,11 +226,11 @@ func (e *Otcbtc) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		selldata := exchange.Order{}
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0], 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0])
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1], 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1])
 		if err != nil {
 			return nil, err
 		}
@@ -271,7 +272,7 @@ func (e *Otcbtc) UpdateAllBalances() {
 		if err == nil {
 			c := e.GetCoinBySymbol(data.Currency)
 			if c != nil {
-				balanceMap.Set(c.Code, freeamount)
+				e.balanceMap.Set(c.Code, freeamount)
 			}
 		} else {
 			log.Printf("%s %s Get Balance Err: %s\n", e.GetName(), data.Currency, err)
@@ -279,7 +280,9 @@ func (e *Otcbtc) UpdateAllBalances() {
 	}
 }
 
+// Withdraw - not viable, the v2 API only has account (/api/v2/users/me) and order endpoints, there is no withdraw endpoint
 func (e *Otcbtc) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -288,6 +291,11 @@ func (e *Otcbtc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	errResponse := &ErrorResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v2/orders"
@@ -295,8 +303,8 @@ func (e *Otcbtc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	mapParams := make(map[string]string)
 	mapParams["market"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "sell"
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["volume"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &errResponse); err != nil {
@@ -310,8 +318,8 @@ func (e *Otcbtc) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.O
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -325,6 +333,11 @@ func (e *Otcbtc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	errResponse := &ErrorResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/api/v2/orders"
@@ -332,8 +345,8 @@ func (e *Otcbtc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	mapParams := make(map[string]string)
 	mapParams["market"] = e.GetSymbolByPair(pair)
 	mapParams["side"] = "buy"
-	mapParams["volume"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["volume"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &errResponse); err != nil {
@@ -347,8 +360,8 @@ func (e *Otcbtc) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.ID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -17,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -66,12 +68,12 @@ func (e *Poloniex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(key)
+			c = exchange.GetCoin(e.GetName(), key)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = key
+				c.Code = exchange.GetCoinCode(e.GetName(), key)
 				c.Name = data.Name
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(key)
@@ -119,8 +121,8 @@ func (e *Poloniex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(coinStrs[0])
-			target := coin.GetCoin(coinStrs[1])
+			base := exchange.GetCoin(e.GetName(), coinStrs[0])
+			target := exchange.GetCoin(e.GetName(), coinStrs[1])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -134,8 +136,8 @@ func (e *Poloniex) GetPairsData() error {
 				ExSymbol:    key,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -177,11 +179,11 @@ func (e *Poloniex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0].(string), 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0].(string))
 		if err != nil {
-			return nil, fmt.Errorf("Poloniex Bids Rate ParseFloat error:%v", err)
+			return nil, fmt.Errorf("Poloniex Bids Rate NewFromString error:%v", err)
 		}
-		buydata.Quantity = bid[1].(float64)
+		buydata.Quantity = decimal.NewFromFloat(bid[1].(float64))
 
 		maker.Bids = append(maker.Bids, buydata)
 	}
@@ -189,11 +191,11 @@ func (e *Poloniex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0].(string), 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0].(string))
 		if err != nil {
-			return nil, fmt.Errorf("Poloniex Asks Rate ParseFloat error:%v", err)
+			return nil, fmt.Errorf("Poloniex Asks Rate NewFromString error:%v", err)
 		}
-		selldata.Quantity = ask[1].(float64)
+		selldata.Quantity = decimal.NewFromFloat(ask[1].(float64))
 
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -230,7 +232,7 @@ func (e *Poloniex) UpdateAllBalances() {
 
 		c := e.GetCoinBySymbol(key)
 		if c != nil {
-			balanceMap.Set(c.Code, freeAmount)
+			e.balanceMap.Set(c.Code, freeAmount)
 		}
 	}
 }
@@ -241,6 +243,11 @@ func (e *Poloniex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string)
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	withdraw := Withdraw{}
 	strRequest := "/tradingApi"
 
@@ -267,14 +274,19 @@ func (e *Poloniex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/tradingApi"
 
 	mapParams := make(map[string]string)
 	mapParams["command"] = "sell"
 	mapParams["currencyPair"] = e.GetSymbolByPair(pair)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["rate"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -286,8 +298,8 @@ func (e *Poloniex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderNumber,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -301,14 +313,19 @@ func (e *Poloniex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/tradingApi"
 
 	mapParams := make(map[string]string)
 	mapParams["command"] = "buy"
 	mapParams["currencyPair"] = e.GetSymbolByPair(pair)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+	mapParams["rate"] = orderRate.String()
+	mapParams["amount"] = orderQuantity.String()
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
@@ -320,8 +337,8 @@ func (e *Poloniex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.OrderNumber,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -397,6 +414,185 @@ func (e *Poloniex) CancelAllOrder() error {
 	return nil
 }
 
+/*************** Margin API ***************/
+/* Poloniex opens a margin position per pair, the loans are taken and repaid by the margin orders,
+the tradable balance stands in for the max borrowable amount */
+func (e *Poloniex) GetMarginBalances(pair *pair.Pair) ([]*exchange.MarginBalance, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	availableBalances := make(map[string]map[string]string)
+	strRequest := "/tradingApi"
+
+	mapParams := make(map[string]string)
+	mapParams["command"] = "returnAvailableAccountBalances"
+	mapParams["account"] = "margin"
+
+	jsonBalanceReturn := e.ApiKeyPost(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &errResponse); err == nil && errResponse.Error != "" {
+		return nil, fmt.Errorf("%s GetMarginBalances Failed: %v", e.GetName(), errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonBalanceReturn), &availableBalances); err != nil {
+		return nil, fmt.Errorf("%s GetMarginBalances Json Unmarshal Err: %v %v", e.GetName(), err, jsonBalanceReturn)
+	}
+
+	position, err := e.getMarginPosition(pair)
+	if err != nil {
+		return nil, err
+	}
+
+	base := &exchange.MarginBalance{Pair: pair, Coin: pair.Base}
+	target := &exchange.MarginBalance{Pair: pair, Coin: pair.Target}
+	base.Free, _ = strconv.ParseFloat(availableBalances["margin"][e.GetSymbolByCoin(pair.Base)], 64)
+	target.Free, _ = strconv.ParseFloat(availableBalances["margin"][e.GetSymbolByCoin(pair.Target)], 64)
+
+	amount, _ := strconv.ParseFloat(position.Amount, 64)
+	total, _ := strconv.ParseFloat(position.Total, 64)
+	lendingFees, _ := strconv.ParseFloat(position.LendingFees, 64)
+	switch position.Type {
+	case "long":
+		base.Borrowed = math.Abs(total)
+		base.Interest = math.Abs(lendingFees)
+	case "short":
+		target.Borrowed = math.Abs(amount)
+		target.Interest = math.Abs(lendingFees)
+	}
+
+	return []*exchange.MarginBalance{base, target}, nil
+}
+
+func (e *Poloniex) getMarginPosition(pair *pair.Pair) (*MarginPosition, error) {
+	errResponse := ErrorResponse{}
+	marginPosition := &MarginPosition{}
+	strRequest := "/tradingApi"
+
+	mapParams := make(map[string]string)
+	mapParams["command"] = "getMarginPosition"
+	mapParams["currencyPair"] = e.GetSymbolByPair(pair)
+
+	jsonPositionReturn := e.ApiKeyPost(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonPositionReturn), &errResponse); err == nil && errResponse.Error != "" {
+		return nil, fmt.Errorf("%s Get Margin Position Failed: %v", e.GetName(), errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonPositionReturn), marginPosition); err != nil {
+		return nil, fmt.Errorf("%s Get Margin Position Json Unmarshal Err: %v %v", e.GetName(), err, jsonPositionReturn)
+	}
+
+	return marginPosition, nil
+}
+
+/* GetMarginLevel - current margin of the whole margin account */
+func (e *Poloniex) GetMarginLevel(pair *pair.Pair) (float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return 0, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	accountSummary := MarginAccountSummary{}
+	strRequest := "/tradingApi"
+
+	mapParams := make(map[string]string)
+	mapParams["command"] = "returnMarginAccountSummary"
+
+	jsonSummaryReturn := e.ApiKeyPost(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonSummaryReturn), &errResponse); err == nil && errResponse.Error != "" {
+		return 0, fmt.Errorf("%s GetMarginLevel Failed: %v", e.GetName(), errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonSummaryReturn), &accountSummary); err != nil {
+		return 0, fmt.Errorf("%s GetMarginLevel Json Unmarshal Err: %v %v", e.GetName(), err, jsonSummaryReturn)
+	}
+
+	return strconv.ParseFloat(accountSummary.CurrentMargin, 64)
+}
+
+func (e *Poloniex) GetMaxBorrowable(pair *pair.Pair, coin *coin.Coin) (float64, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return 0, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
+	}
+
+	errResponse := ErrorResponse{}
+	tradableBalances := make(map[string]map[string]string)
+	strRequest := "/tradingApi"
+
+	mapParams := make(map[string]string)
+	mapParams["command"] = "returnTradableBalances"
+
+	jsonTradableReturn := e.ApiKeyPost(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonTradableReturn), &errResponse); err == nil && errResponse.Error != "" {
+		return 0, fmt.Errorf("%s GetMaxBorrowable Failed: %v", e.GetName(), errResponse.Error)
+	}
+	if err := json.Unmarshal([]byte(jsonTradableReturn), &tradableBalances); err != nil {
+		return 0, fmt.Errorf("%s GetMaxBorrowable Json Unmarshal Err: %v %v", e.GetName(), err, jsonTradableReturn)
+	}
+
+	balances, ok := tradableBalances[e.GetSymbolByPair(pair)]
+	if !ok {
+		return 0, fmt.Errorf("%s GetMaxBorrowable %s is not a margin pair", e.GetName(), e.GetSymbolByPair(pair))
+	}
+
+	return strconv.ParseFloat(balances[e.GetSymbolByCoin(coin)], 64)
+}
+
+func (e *Poloniex) Borrow(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	return fmt.Errorf("%s Borrow not viable with API, the loan is taken by the margin order", e.GetName())
+}
+
+func (e *Poloniex) Repay(pair *pair.Pair, coin *coin.Coin, quantity float64) error {
+	return fmt.Errorf("%s Repay not viable with API, the loan is repaid by closing the margin position", e.GetName())
+}
+
+func (e *Poloniex) MarginLimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "marginSell", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitSell %v", e.GetName(), err)
+	}
+	order.Side = "Sell"
+	return order, nil
+}
+
+func (e *Poloniex) MarginLimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Order, error) {
+	order, err := e.placeMarginOrder(pair, "marginBuy", quantity, rate)
+	if err != nil {
+		return nil, fmt.Errorf("%s MarginLimitBuy %v", e.GetName(), err)
+	}
+	order.Side = "Buy"
+	return order, nil
+}
+
+func (e *Poloniex) placeMarginOrder(pair *pair.Pair, command string, quantity, rate float64) (*exchange.Order, error) {
+	if e.API_KEY == "" || e.API_SECRET == "" {
+		return nil, fmt.Errorf("API Key or Secret Key are nil")
+	}
+
+	placeOrder := PlaceOrder{}
+	strRequest := "/tradingApi"
+
+	mapParams := make(map[string]string)
+	mapParams["command"] = command
+	mapParams["currencyPair"] = e.GetSymbolByPair(pair)
+	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
+
+	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
+	if err := json.Unmarshal([]byte(jsonPlaceReturn), &placeOrder); err != nil {
+		return nil, fmt.Errorf("Json Unmarshal Err: %v %v", err, jsonPlaceReturn)
+	} else if placeOrder.OrderNumber == "" {
+		return nil, fmt.Errorf("Failed: %v", jsonPlaceReturn)
+	}
+
+	order := &exchange.Order{
+		Pair:         pair,
+		OrderID:      placeOrder.OrderNumber,
+		Rate:         decimal.NewFromFloat(rate),
+		Quantity:     decimal.NewFromFloat(quantity),
+		Status:       exchange.New,
+		JsonResponse: jsonPlaceReturn,
+	}
+	return order, nil
+}
+
 /*************** Signature Http Request ***************/
 /*Method: API Request and Signature is required
 Step 1: Change Instance Name    (e *<exchange Instance Name>)
@@ -75,3 +75,25 @@ type CancelOrder struct {
 	Amount  string `json:"amount"`
 	Message string `json:"message"`
 }
+
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+type MarginAccountSummary struct {
+	TotalValue         string `json:"totalValue"`
+	Pl                 string `json:"pl"`
+	LendingFees        string `json:"lendingFees"`
+	NetValue           string `json:"netValue"`
+	TotalBorrowedValue string `json:"totalBorrowedValue"`
+	CurrentMargin      string `json:"currentMargin"`
+}
+
+type MarginPosition struct {
+	Amount      string `json:"amount"`
+	Total       string `json:"total"`
+	BasePrice   string `json:"basePrice"`
+	Pl          string `json:"pl"`
+	LendingFees string `json:"lendingFees"`
+	Type        string `json:"type"`
+}
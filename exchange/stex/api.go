@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -72,12 +72,12 @@ func (e *Stex) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Code)
+			c = exchange.GetCoin(e.GetName(), data.Code)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Code
+				c.Code = exchange.GetCoinCode(e.GetName(), data.Code)
 				c.Name = data.Name
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Code)
@@ -127,8 +127,8 @@ func (e *Stex) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.MarketCode)
-			target := coin.GetCoin(data.CurrencyCode)
+			base := exchange.GetCoin(e.GetName(), data.MarketCode)
+			target := exchange.GetCoin(e.GetName(), data.CurrencyCode)
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -143,8 +143,8 @@ func (e *Stex) GetPairsData() error {
 				ExSymbol:    data.Symbol,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(data.CurrencyPrecision * -1),
-				PriceFilter: math.Pow10(data.MarketPrecision * -1),
+				LotSize:     decimal.New(1, int32(data.CurrencyPrecision*-1)),
+				PriceFilter: decimal.New(1, int32(data.MarketPrecision*-1)),
 				Listed:      true,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -187,13 +187,13 @@ func (e *Stex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid.Price, 64)
+		buydata.Rate, err = decimal.NewFromString(bid.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid.Amount, 64)
+		buydata.Quantity, err = decimal.NewFromString(bid.Amount)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
 		maker.Bids = append(maker.Bids, buydata)
@@ -203,13 +203,13 @@ func (e *Stex) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask.Price, 64)
+		selldata.Rate, err = decimal.NewFromString(ask.Price)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Rate error:%v", e.GetName(), err)
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask.Amount, 64)
+		selldata.Quantity, err = decimal.NewFromString(ask.Amount)
 		if err != nil {
-			return nil, fmt.Errorf("%s OrderBook strconv.ParseFloat Quantity error:%v", e.GetName(), err)
+			return nil, fmt.Errorf("%s OrderBook decimal.NewFromString Quantity error:%v", e.GetName(), err)
 		}
 
 		maker.Asks = append(maker.Asks, selldata)
@@ -263,7 +263,7 @@ func (e *Stex) UpdateAllBalances() {
 			if err != nil {
 				log.Printf("Parse stex balance error: %v", err)
 			}
-			balanceMap.Set(c.Code, Fundf)
+			e.balanceMap.Set(c.Code, Fundf)
 		}
 	}
 }
@@ -274,6 +274,11 @@ func (e *Stex) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) boo
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	withdraw := Withdraw{}
 
 	mapParams := make(map[string]string)
@@ -305,13 +310,18 @@ func (e *Stex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := TradeDetail{}
 	jsonResponse := JsonResponse{}
 
 	mapParams := make(map[string]string)
 	mapParams["method"] = "Trade"
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["rate"] = orderRate.String()
 	mapParams["type"] = "SELL"
 	mapParams["pair"] = e.GetSymbolByPair(pair)
 
@@ -329,8 +339,8 @@ func (e *Stex) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      orderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -344,13 +354,18 @@ func (e *Stex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := TradeDetail{}
 	jsonResponse := JsonResponse{}
 
 	mapParams := make(map[string]string)
 	mapParams["method"] = "Trade"
-	mapParams["amount"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["rate"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["amount"] = orderQuantity.String()
+	mapParams["rate"] = orderRate.String()
 	mapParams["type"] = "BUY"
 	mapParams["pair"] = e.GetSymbolByPair(pair)
 
@@ -368,8 +383,8 @@ func (e *Stex) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Orde
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      orderID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -428,15 +443,15 @@ func (e *Stex) OrderStatus(order *exchange.Order) error {
 			var orderAmount, dealAmount float64
 			if order.Side == "sell" {
 				orderAmount, _ = strconv.ParseFloat(fmt.Sprintf("%v", orderDetail[order.OrderID].SellAmount), 64)
-				dealAmount = orderAmount / order.Rate
+				dealAmount = orderAmount / order.Rate.InexactFloat64()
 			} else {
 				orderAmount, _ = strconv.ParseFloat(fmt.Sprintf("%v", orderDetail[order.OrderID].BuyAmount), 64)
-				dealAmount = orderAmount / order.Rate
+				dealAmount = orderAmount / order.Rate.InexactFloat64()
 			}
 
 			if dealAmount == 0 {
 				order.Status = exchange.New
-			} else if dealAmount > 0 && dealAmount < order.Quantity {
+			} else if dealAmount > 0 && dealAmount < order.Quantity.InexactFloat64() {
 				order.Status = exchange.Partial
 			}
 
@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"net/http"
 	"strconv"
 	"strings"
@@ -18,6 +17,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -65,17 +65,17 @@ func (e *Tokok) GetCoinsData() error {
 		target := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base = coin.GetCoin(data.QuoteAsset)
+			base = exchange.GetCoin(e.GetName(), data.QuoteAsset)
 			if base == nil {
 				base = &coin.Coin{}
-				base.Code = data.QuoteAsset
-				coin.AddCoin(base)
+				base.Code = exchange.GetCoinCode(e.GetName(), data.QuoteAsset)
+				base = coin.GetOrAddCoin(base)
 			}
-			target = coin.GetCoin(data.BaseAsset)
+			target = exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if target == nil {
 				target = &coin.Coin{}
-				target.Code = data.BaseAsset
-				coin.AddCoin(target)
+				target.Code = exchange.GetCoinCode(e.GetName(), data.BaseAsset)
+				target = coin.GetOrAddCoin(target)
 			}
 		case exchange.JSON_FILE:
 			base = e.GetCoinBySymbol(data.QuoteAsset)
@@ -134,8 +134,8 @@ func (e *Tokok) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(data.QuoteAsset)
-			target := coin.GetCoin(data.BaseAsset)
+			base := exchange.GetCoin(e.GetName(), data.QuoteAsset)
+			target := exchange.GetCoin(e.GetName(), data.BaseAsset)
 			if base != nil && target != nil {
 
 				p = pair.GetPair(base, target)
@@ -151,8 +151,8 @@ func (e *Tokok) GetPairsData() error {
 				ExSymbol:    strings.ToLower(data.Symbol),
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     math.Pow10(-1 * data.BaseAssetPrecision),
-				PriceFilter: math.Pow10(-1 * data.QuoteAssetPrecision),
+				LotSize:     decimal.New(1, int32(-1*data.BaseAssetPrecision)),
+				PriceFilter: decimal.New(1, int32(-1*data.QuoteAssetPrecision)),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -190,11 +190,11 @@ func (e *Tokok) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var buydata exchange.Order
 
 		//Modify according to type and structure
-		buydata.Rate, err = strconv.ParseFloat(bid[0].(string), 64)
+		buydata.Rate, err = decimal.NewFromString(bid[0].(string))
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(bid[1].(string), 64)
+		buydata.Quantity, err = decimal.NewFromString(bid[1].(string))
 		if err != nil {
 			return nil, err
 		}
@@ -205,11 +205,11 @@ func (e *Tokok) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 		var selldata exchange.Order
 
 		//Modify according to type and structure
-		selldata.Rate, err = strconv.ParseFloat(ask[0].(string), 64)
+		selldata.Rate, err = decimal.NewFromString(ask[0].(string))
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(ask[1].(string), 64)
+		selldata.Quantity, err = decimal.NewFromString(ask[1].(string))
 		if err != nil {
 			return nil, err
 		}
@@ -251,12 +251,14 @@ func (e *Tokok) UpdateAllBalances() {
 				log.Printf("%s UpdateAllBalances Failed: %v", e.GetName(), v.HotMoney)
 				return
 			}
-			balanceMap.Set(c.Code, freeamount)
+			e.balanceMap.Set(c.Code, freeamount)
 		}
 	}
 }
 
+// Withdraw - not viable, the v1 API only has account and trade endpoints, there is no withdraw endpoint
 func (e *Tokok) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -265,12 +267,17 @@ func (e *Tokok) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := JsonResponse{}
 	placeOrder := ""
 	strRequest := "/trade"
 
-	price := float64(int(rate/e.GetPriceFilter(pair)+e.GetPriceFilter(pair)/10)) * (e.GetPriceFilter(pair))
-	amount := float64(int(quantity/e.GetLotSize(pair)+e.GetLotSize(pair)/10)) * (e.GetLotSize(pair))
+	price := json.Number(orderRate.String())
+	amount := json.Number(orderQuantity.String())
 
 	mapParams := make(map[string]interface{})
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
@@ -293,8 +300,8 @@ func (e *Tokok) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchange.Or
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -308,12 +315,17 @@ func (e *Tokok) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := JsonResponse{}
 	placeOrder := ""
 	strRequest := "/trade"
 
-	price := float64(int(rate/e.GetPriceFilter(pair)+e.GetPriceFilter(pair)/10)) * (e.GetPriceFilter(pair))
-	amount := float64(int(quantity/e.GetLotSize(pair)+e.GetLotSize(pair)/10)) * (e.GetLotSize(pair))
+	price := json.Number(orderRate.String())
+	amount := json.Number(orderQuantity.String())
 
 	mapParams := make(map[string]interface{})
 	mapParams["symbol"] = e.GetSymbolByPair(pair)
@@ -336,8 +348,8 @@ func (e *Tokok) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange.Ord
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -18,6 +18,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -67,17 +68,17 @@ func (e *Tradeogre) GetCoinsData() error {
 			target := &coin.Coin{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base = coin.GetCoin(coinStrs[0])
+				base = exchange.GetCoin(e.GetName(), coinStrs[0])
 				if base == nil {
 					base = &coin.Coin{}
-					base.Code = coinStrs[0]
-					coin.AddCoin(base)
+					base.Code = exchange.GetCoinCode(e.GetName(), coinStrs[0])
+					base = coin.GetOrAddCoin(base)
 				}
-				target = coin.GetCoin(coinStrs[1])
+				target = exchange.GetCoin(e.GetName(), coinStrs[1])
 				if target == nil {
 					target = &coin.Coin{}
-					target.Code = coinStrs[1]
-					coin.AddCoin(target)
+					target.Code = exchange.GetCoinCode(e.GetName(), coinStrs[1])
+					target = coin.GetOrAddCoin(target)
 				}
 			case exchange.JSON_FILE:
 				base = e.GetCoinBySymbol(coinStrs[0])
@@ -139,8 +140,8 @@ func (e *Tradeogre) GetPairsData() error {
 			p := &pair.Pair{}
 			switch e.Source {
 			case exchange.EXCHANGE_API:
-				base := coin.GetCoin(coinStrs[0])
-				target := coin.GetCoin(coinStrs[1])
+				base := exchange.GetCoin(e.GetName(), coinStrs[0])
+				target := exchange.GetCoin(e.GetName(), coinStrs[1])
 				if base != nil && target != nil {
 					p = pair.GetPair(base, target)
 				}
@@ -154,8 +155,8 @@ func (e *Tradeogre) GetPairsData() error {
 					ExSymbol:    key,
 					MakerFee:    DEFAULT_MAKER_FEE,
 					TakerFee:    DEFAULT_TAKER_FEE,
-					LotSize:     DEFAULT_LOT_SIZE,
-					PriceFilter: DEFAULT_PRICE_FILTER,
+					LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+					PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 					Listed:      DEFAULT_LISTED,
 				}
 				e.SetPairConstraint(pairConstraint)
@@ -202,11 +203,11 @@ func (e *Tradeogre) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for i := len(buyRates) - 1; i >= 0; i-- {
 		var buydata exchange.Order
 
-		buydata.Rate, err = strconv.ParseFloat(buyRates[i], 64)
+		buydata.Rate, err = decimal.NewFromString(buyRates[i])
 		if err != nil {
 			return nil, err
 		}
-		buydata.Quantity, err = strconv.ParseFloat(orderBook.Buy[buyRates[i]], 64)
+		buydata.Quantity, err = decimal.NewFromString(orderBook.Buy[buyRates[i]])
 		if err != nil {
 			return nil, err
 		}
@@ -222,11 +223,11 @@ func (e *Tradeogre) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, rate := range sellRates {
 		var selldata exchange.Order
 
-		selldata.Rate, err = strconv.ParseFloat(rate, 64)
+		selldata.Rate, err = decimal.NewFromString(rate)
 		if err != nil {
 			return nil, err
 		}
-		selldata.Quantity, err = strconv.ParseFloat(orderBook.Sell[rate], 64)
+		selldata.Quantity, err = decimal.NewFromString(orderBook.Sell[rate])
 		if err != nil {
 			return nil, err
 		}
@@ -264,13 +265,14 @@ func (e *Tradeogre) UpdateAllBalances() {
 				log.Printf("%s balance parse error: %v, %v", e.GetName(), err, data)
 				return
 			}
-			balanceMap.Set(c.Code, freeBalance)
+			e.balanceMap.Set(c.Code, freeBalance)
 		}
 	}
 }
 
+// Withdraw - not viable, the v1 API only has balance and order endpoints, there is no withdraw endpoint
 func (e *Tradeogre) Withdraw(coin *coin.Coin, quantity float64, addr, tag string) bool {
-
+	log.Printf("%s Withdraw Not Viable with API.", e.GetName())
 	return false
 }
 
@@ -279,12 +281,17 @@ func (e *Tradeogre) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchang
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/order/sell"
 
 	mapParams := make(map[string]string)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["quantity"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["market"] = e.GetSymbolByPair(pair)
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
@@ -297,8 +304,8 @@ func (e *Tradeogre) LimitSell(pair *pair.Pair, quantity, rate float64) (*exchang
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.UUID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -312,12 +319,17 @@ func (e *Tradeogre) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	placeOrder := PlaceOrder{}
 	strRequest := "/order/buy"
 
 	mapParams := make(map[string]string)
-	mapParams["quantity"] = strconv.FormatFloat(quantity, 'f', -1, 64)
-	mapParams["price"] = strconv.FormatFloat(rate, 'f', -1, 64)
+	mapParams["quantity"] = orderQuantity.String()
+	mapParams["price"] = orderRate.String()
 	mapParams["market"] = e.GetSymbolByPair(pair)
 
 	jsonPlaceReturn := e.ApiKeyRequest("POST", strRequest, mapParams)
@@ -330,8 +342,8 @@ func (e *Tradeogre) LimitBuy(pair *pair.Pair, quantity, rate float64) (*exchange
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      placeOrder.UUID,
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -367,9 +379,9 @@ func (e *Tradeogre) OrderStatus(order *exchange.Order) error {
 	if err != nil {
 		return fmt.Errorf("%s orderStatus parse error: %v, %v", e.GetName(), err, orderStatus.Fulfilled)
 	}
-	if fulfilledFloat == order.Quantity {
+	if fulfilledFloat == order.Quantity.InexactFloat64() {
 		order.Status = exchange.Filled
-	} else if fulfilledFloat > 0 && fulfilledFloat < order.Quantity {
+	} else if fulfilledFloat > 0 && fulfilledFloat < order.Quantity.InexactFloat64() {
 		order.Status = exchange.Partial
 	} else if fulfilledFloat == 0 {
 		order.Status = exchange.New
@@ -22,6 +22,7 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -74,12 +75,12 @@ func (e *TradeSatoshi) GetCoinsData() error {
 		c := &coin.Coin{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			c = coin.GetCoin(data.Currency)
+			c = exchange.GetCoin(e.GetName(), data.Currency)
 			if c == nil {
 				c = &coin.Coin{}
-				c.Code = data.Currency
+				c.Code = exchange.GetCoinCode(e.GetName(), data.Currency)
 				c.Name = data.CurrencyLong
-				coin.AddCoin(c)
+				c = coin.GetOrAddCoin(c)
 			}
 		case exchange.JSON_FILE:
 			c = e.GetCoinBySymbol(data.Currency)
@@ -129,8 +130,8 @@ func (e *TradeSatoshi) GetPairsData() error {
 		p := &pair.Pair{}
 		switch e.Source {
 		case exchange.EXCHANGE_API:
-			base := coin.GetCoin(coinStrs[1])
-			target := coin.GetCoin(coinStrs[0])
+			base := exchange.GetCoin(e.GetName(), coinStrs[1])
+			target := exchange.GetCoin(e.GetName(), coinStrs[0])
 			if base != nil && target != nil {
 				p = pair.GetPair(base, target)
 			}
@@ -144,8 +145,8 @@ func (e *TradeSatoshi) GetPairsData() error {
 				ExSymbol:    data.Market,
 				MakerFee:    DEFAULT_MAKER_FEE,
 				TakerFee:    DEFAULT_TAKER_FEE,
-				LotSize:     DEFAULT_LOT_SIZE,
-				PriceFilter: DEFAULT_PRICE_FILTER,
+				LotSize:     decimal.NewFromFloat(DEFAULT_LOT_SIZE),
+				PriceFilter: decimal.NewFromFloat(DEFAULT_PRICE_FILTER),
 				Listed:      DEFAULT_LISTED,
 			}
 			e.SetPairConstraint(pairConstraint)
@@ -192,16 +193,16 @@ func (e *TradeSatoshi) OrderBook(pair *pair.Pair) (*exchange.Maker, error) {
 	for _, bid := range orderBook.Buy {
 		var buydata exchange.Order
 
-		buydata.Rate = bid.Rate
-		buydata.Quantity = bid.Quantity
+		buydata.Rate = decimal.NewFromFloat(bid.Rate)
+		buydata.Quantity = decimal.NewFromFloat(bid.Quantity)
 
 		maker.Bids = append(maker.Bids, buydata)
 	}
 	for _, ask := range orderBook.Sell {
 		var selldata exchange.Order
 
-		selldata.Rate = ask.Rate
-		selldata.Quantity = ask.Quantity
+		selldata.Rate = decimal.NewFromFloat(ask.Rate)
+		selldata.Quantity = decimal.NewFromFloat(ask.Quantity)
 
 		maker.Asks = append(maker.Asks, selldata)
 	}
@@ -235,7 +236,7 @@ func (e *TradeSatoshi) UpdateAllBalances() {
 	for _, v := range accountBalance {
 		c := e.GetCoinBySymbol(v.Currency)
 		if c != nil {
-			balanceMap.Set(c.Code, v.Available)
+			e.balanceMap.Set(c.Code, v.Available)
 		}
 	}
 }
@@ -246,6 +247,11 @@ func (e *TradeSatoshi) Withdraw(coin *coin.Coin, quantity float64, addr, tag str
 		return false
 	}
 
+	if err := exchange.ValidateWithdraw(e.GetCoinConstraint(coin), quantity); err != nil {
+		log.Printf("%s Withdraw Failed: %v", e.GetName(), err)
+		return false
+	}
+
 	jsonResponse := &JsonResponse{}
 	withdraw := Withdraw{}
 	strRequest := "/private/submitwithdraw"
@@ -276,6 +282,11 @@ func (e *TradeSatoshi) LimitSell(pair *pair.Pair, quantity, rate float64) (*exch
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Sell", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/private/submitorder"
@@ -283,8 +294,8 @@ func (e *TradeSatoshi) LimitSell(pair *pair.Pair, quantity, rate float64) (*exch
 	mapParams := make(map[string]interface{})
 	mapParams["Market"] = e.GetSymbolByPair(pair)
 	mapParams["Type"] = "Sell"
-	mapParams["Amount"] = quantity
-	mapParams["Price"] = rate
+	mapParams["Amount"] = json.Number(orderQuantity.String())
+	mapParams["Price"] = json.Number(orderRate.String())
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -299,8 +310,8 @@ func (e *TradeSatoshi) LimitSell(pair *pair.Pair, quantity, rate float64) (*exch
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Sell",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -314,6 +325,11 @@ func (e *TradeSatoshi) LimitBuy(pair *pair.Pair, quantity, rate float64) (*excha
 		return nil, fmt.Errorf("%s API Key or Secret Key are nil", e.GetName())
 	}
 
+	orderQuantity, orderRate, err := exchange.ValidateOrder(e.GetPairConstraint(pair), "Buy", quantity, rate)
+	if err != nil {
+		return nil, err
+	}
+
 	jsonResponse := &JsonResponse{}
 	placeOrder := PlaceOrder{}
 	strRequest := "/private/submitorder"
@@ -321,8 +337,8 @@ func (e *TradeSatoshi) LimitBuy(pair *pair.Pair, quantity, rate float64) (*excha
 	mapParams := make(map[string]interface{})
 	mapParams["Market"] = e.GetSymbolByPair(pair)
 	mapParams["Type"] = "Buy"
-	mapParams["Amount"] = quantity
-	mapParams["Price"] = rate
+	mapParams["Amount"] = json.Number(orderQuantity.String())
+	mapParams["Price"] = json.Number(orderRate.String())
 
 	jsonPlaceReturn := e.ApiKeyPost(strRequest, mapParams)
 	if err := json.Unmarshal([]byte(jsonPlaceReturn), &jsonResponse); err != nil {
@@ -337,8 +353,8 @@ func (e *TradeSatoshi) LimitBuy(pair *pair.Pair, quantity, rate float64) (*excha
 	order := &exchange.Order{
 		Pair:         pair,
 		OrderID:      fmt.Sprintf("%v", placeOrder.OrderID),
-		Rate:         rate,
-		Quantity:     quantity,
+		Rate:         orderRate,
+		Quantity:     orderQuantity,
 		Side:         "Buy",
 		Status:       exchange.New,
 		JsonResponse: jsonPlaceReturn,
@@ -0,0 +1,80 @@
+package exchange
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ValidateOrder - rounds the quantity down to the lot size and the rate to the price filter, down for a "Buy"
+// and up for a "Sell" so the order never crosses the caller's rate, then rejects an order the exchange would refuse,
+// a rate of 0 is a market order. Adapters call it before placing orders and send the decimals it returns as they are
+func ValidateOrder(pairConstraint *PairConstraint, side string, quantity, rate float64) (decimal.Decimal, decimal.Decimal, error) {
+	orderQuantity, orderRate := decimal.NewFromFloat(quantity), decimal.NewFromFloat(rate)
+	roundRate := decimal.Decimal.Floor
+	switch side {
+	case "Buy":
+	case "Sell":
+		roundRate = decimal.Decimal.Ceil
+	default:
+		return orderQuantity, orderRate, fmt.Errorf("Order Side %q is not Buy or Sell", side)
+	}
+	if pairConstraint == nil {
+		return orderQuantity, orderRate, nil
+	}
+
+	market := orderRate.IsZero()
+	orderQuantity = RoundStep(orderQuantity, pairConstraint.LotSize, decimal.Decimal.Floor)
+	orderRate = RoundStep(orderRate, pairConstraint.PriceFilter, roundRate)
+
+	minQuantity, maxQuantity := pairConstraint.MinQuantity, pairConstraint.MaxQuantity
+	if market && pairConstraint.MarketMinQuantity.IsPositive() {
+		minQuantity = pairConstraint.MarketMinQuantity
+	}
+	if market && pairConstraint.MarketMaxQuantity.IsPositive() {
+		maxQuantity = pairConstraint.MarketMaxQuantity
+	}
+
+	switch {
+	case !orderQuantity.IsPositive():
+		return orderQuantity, orderRate, fmt.Errorf("%s Order Quantity %v is not positive after rounding to %v", pairConstraint.ExSymbol, orderQuantity, pairConstraint.LotSize)
+	case !market && !orderRate.IsPositive():
+		return orderQuantity, orderRate, fmt.Errorf("%s Order Rate %v is not positive after rounding to %v", pairConstraint.ExSymbol, orderRate, pairConstraint.PriceFilter)
+	case orderQuantity.LessThan(minQuantity):
+		return orderQuantity, orderRate, fmt.Errorf("%s Order Quantity %v is less than the minimum %v", pairConstraint.ExSymbol, orderQuantity, minQuantity)
+	case maxQuantity.IsPositive() && orderQuantity.GreaterThan(maxQuantity):
+		return orderQuantity, orderRate, fmt.Errorf("%s Order Quantity %v is more than the maximum %v", pairConstraint.ExSymbol, orderQuantity, maxQuantity)
+	case !market && orderQuantity.Mul(orderRate).LessThan(pairConstraint.MinNotional):
+		return orderQuantity, orderRate, fmt.Errorf("%s Order Value %v is less than the minimum %v", pairConstraint.ExSymbol, orderQuantity.Mul(orderRate), pairConstraint.MinNotional)
+	}
+	return orderQuantity, orderRate, nil
+}
+
+// ValidateWithdraw - rejects a withdraw the exchange would refuse, adapters call it before the withdraw API
+func ValidateWithdraw(coinConstraint *CoinConstraint, quantity float64) error {
+	if coinConstraint == nil {
+		return nil
+	}
+	if quantity <= 0 {
+		return fmt.Errorf("%s Withdraw Quantity %v is not positive", coinConstraint.ExSymbol, quantity)
+	}
+	if quantity < coinConstraint.MinWithdraw {
+		return fmt.Errorf("%s Withdraw Quantity %v is less than the minimum %v", coinConstraint.ExSymbol, quantity, coinConstraint.MinWithdraw)
+	}
+	if !isMultiple(quantity, coinConstraint.WithdrawStep) {
+		return fmt.Errorf("%s Withdraw Quantity %v is not a multiple of %v", coinConstraint.ExSymbol, quantity, coinConstraint.WithdrawStep)
+	}
+	return nil
+}
+
+// isMultiple - true if there is no step, both are parsed as the shortest decimal so 0.3 is a multiple of 0.1
+func isMultiple(quantity, step float64) bool {
+	if step <= 0 {
+		return true
+	}
+	return decimal.NewFromFloat(quantity).Mod(decimal.NewFromFloat(step)).IsZero()
+}
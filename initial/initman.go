@@ -72,318 +72,326 @@ func (e *InitManager) Init(config *exchange.Config) exchange.Exchange {
 	case exchange.BINANCE:
 		ex := binance.CreateBinance(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITTREX:
 		ex := bittrex.CreateBittrex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.COINEX:
 		ex := coinex.CreateCoinex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.STEX:
 		ex := stex.CreateStex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITMEX:
 		ex := bitmex.CreateBitmex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.KUCOIN:
 		ex := kucoin.CreateKucoin(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.HUOBIOTC:
 		ex := huobiotc.CreateHuobiOTC(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITMAX:
 		ex := bitmax.CreateBitmax(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITSTAMP:
 		ex := bitstamp.CreateBitstamp(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.OTCBTC:
 		ex := otcbtc.CreateOtcbtc(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.HUOBI:
 		ex := huobi.CreateHuobi(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BIBOX:
 		ex := bibox.CreateBibox(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.OKEX:
 		ex := okex.CreateOkex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITZ:
 		ex := bitz.CreateBitz(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.HITBTC:
 		ex := hitbtc.CreateHitbtc(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.DRAGONEX:
 		ex := dragonex.CreateDragonex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BIGONE:
 		ex := bigone.CreateBigone(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITFINEX:
 		ex := bitfinex.CreateBitfinex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.GATEIO:
 		ex := gateio.CreateGateio(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.IDEX:
 		ex := idex.CreateIdex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.LIQUID:
 		ex := liquid.CreateLiquid(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITFOREX:
 		ex := bitforex.CreateBitforex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.TOKOK:
 		ex := tokok.CreateTokok(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.MXC:
 		ex := mxc.CreateMxc(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITRUE:
 		ex := bitrue.CreateBitrue(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.TRADESATOSHI:
 		ex := tradesatoshi.CreateTradeSatoshi(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.KRAKEN:
 		ex := kraken.CreateKraken(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.POLONIEX:
 		ex := poloniex.CreatePoloniex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.COINEAL:
 		ex := coineal.CreateCoineal(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.TRADEOGRE:
 		ex := tradeogre.CreateTradeogre(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.COINBENE:
 		ex := coinbene.CreateCoinbene(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.IBANKDIGITAL:
 		ex := ibankdigital.CreateIbankdigital(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.LBANK:
 		ex := lbank.CreateLbank(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BINANCEDEX:
 		ex := binancedex.CreateBinanceDex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITMART:
 		ex := bitmart.CreateBitmart(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BIKI:
 		ex := biki.CreateBiki(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.DCOIN:
 		ex := dcoin.CreateDcoin(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.COINTIGER:
 		ex := cointiger.CreateCointiger(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.HUOBIDM:
 		ex := huobidm.CreateHuobidm(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BW:
 		ex := bw.CreateBw(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BITBAY:
 		ex := bitbay.CreateBitbay(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.DERIBIT:
 		ex := deribit.CreateDeribit(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.OKEXDM:
 		ex := okexdm.CreateOkexdm(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.GOKO:
 		ex := goko.CreateGoko(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	case exchange.BCEX:
 		ex := bcex.CreateBcex(config)
 		if ex != nil {
-			e.exMan.Add(ex)
+			e.add(config, ex)
 		}
 		return ex
 
 	}
 	return nil
 }
+
+func (e *InitManager) add(config *exchange.Config, ex exchange.Exchange) {
+	if config.Account != "" {
+		e.exMan.AddAccount(config.Account, ex)
+	} else {
+		e.exMan.Add(ex)
+	}
+}
@@ -6,9 +6,11 @@ package pair
 
 import (
 	"fmt"
+	"log"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	coin "github.com/bitontop/gored/coin"
 	cmap "github.com/orcaman/concurrent-map"
@@ -22,55 +24,81 @@ type Pair struct {
 	Target *coin.Coin
 }
 
-var pairMap cmap.ConcurrentMap
+var pairMap cmap.ConcurrentMap // ID -> pair
+var keyMap cmap.ConcurrentMap  // name -> pair, the index of GetPairByKey
+var coinMap cmap.ConcurrentMap // base ID|target ID -> pair, the index of GetPair, names aren't unique when coins share a code
+var lock sync.Mutex            // keeps the maps in step on set and delete
 
 func Init() {
 	if pairMap == nil {
 		pairMap = cmap.New()
 	}
-}
-
-func GeneratePairID() int {
-	if pairMap.Count() > 0 {
-		keySort := []int{}
-		for _, key := range pairMap.Keys() {
-			if id, err := strconv.Atoi(key); err == nil {
-				keySort = append(keySort, id)
-			}
-		}
-		sort.Ints(keySort)
-		return keySort[len(keySort)-1] + 1
-	} else {
-		return 1
+	if keyMap == nil {
+		keyMap = cmap.New()
+	}
+	if coinMap == nil {
+		coinMap = cmap.New()
 	}
 }
 
 func SetPair(id int, base, target *coin.Coin) *Pair {
 	if base != nil && target != nil {
-		key := GetKey(base, target)
-		p := &Pair{id, key, base, target}
-		pairMap.Set(fmt.Sprintf("%d", id), p)
-		return p
+		lock.Lock()
+		defer lock.Unlock()
+		return setPair(id, base, target)
 	} else {
 		return nil
 	}
 }
 
+func setPair(id int, base, target *coin.Coin) *Pair {
+	key := GetKey(base, target)
+	p := &Pair{id, key, base, target}
+	idKey := fmt.Sprintf("%d", id)
+	if tmp, ok := pairMap.Get(idKey); ok {
+		removeKey(tmp.(*Pair))
+	}
+	pairMap.Set(idKey, p)
+	keyMap.Set(key, p)
+	coinMap.Set(getCoinKey(base, target), p)
+	return p
+}
+
 func GetPairID(name string) int {
 	return GetPairByKey(name).ID
 }
 
+// GetPair - gets or creates the pair, nil if it can't be created, see GetOrAddPair
 func GetPair(base, target *coin.Coin) *Pair {
-	for _, id := range pairMap.Keys() {
-		if tmp, ok := pairMap.Get(id); ok {
-			p := tmp.(*Pair)
-			if p.Base.ID == base.ID && p.Target.ID == target.ID {
-				return p
-			}
-		}
+	p, err := GetOrAddPair(base, target)
+	if err != nil {
+		log.Printf("%v", err)
+	}
+	return p
+}
+
+// GetOrAddPair - gets or creates the pair, a new pair ID is stable across runs, see coin.GenerateStableID
+func GetOrAddPair(base, target *coin.Coin) (*Pair, error) {
+	if base == nil || target == nil {
+		return nil, fmt.Errorf("Add Pair Err: base %v or target %v is nil", base, target)
+	}
+	coinKey := getCoinKey(base, target)
+	if tmp, ok := coinMap.Get(coinKey); ok {
+		return tmp.(*Pair), nil
 	}
 
-	return SetPair(GeneratePairID(), base, target)
+	lock.Lock()
+	defer lock.Unlock()
+	if tmp, ok := coinMap.Get(coinKey); ok {
+		return tmp.(*Pair), nil
+	}
+	id, err := coin.GenerateStableID(coinKey, func(id int) bool {
+		return pairMap.Has(fmt.Sprintf("%d", id))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Add Pair %s Err: %v", GetKey(base, target), err)
+	}
+	return setPair(id, base, target), nil
 }
 
 func GetString(pair *Pair) string {
@@ -88,13 +116,8 @@ func GetKey(base, target *coin.Coin) string {
 
 func GetPairByKey(key string) *Pair {
 	key = strings.ToUpper(key)
-	for _, id := range pairMap.Keys() {
-		if tmp, ok := pairMap.Get(id); ok {
-			p := tmp.(*Pair)
-			if p.Name == key {
-				return p
-			}
-		}
+	if tmp, ok := keyMap.Get(key); ok {
+		return tmp.(*Pair)
 	}
 	return nil
 }
@@ -123,5 +146,25 @@ func GetPairs() []*Pair {
 }
 
 func DeletePair(pair *Pair) {
-	pairMap.Remove(fmt.Sprintf("%d", pair.ID))
+	lock.Lock()
+	defer lock.Unlock()
+
+	idKey := fmt.Sprintf("%d", pair.ID)
+	if tmp, ok := pairMap.Get(idKey); ok {
+		removeKey(tmp.(*Pair))
+	}
+	pairMap.Remove(idKey)
+}
+
+// removeKey - drops the indexes only if they still point to the pair
+func removeKey(pair *Pair) {
+	samePair := func(key string, v interface{}, exists bool) bool {
+		return exists && v.(*Pair).ID == pair.ID
+	}
+	keyMap.RemoveCb(pair.Name, samePair)
+	coinMap.RemoveCb(getCoinKey(pair.Base, pair.Target), samePair)
+}
+
+func getCoinKey(base, target *coin.Coin) string {
+	return fmt.Sprintf("%d|%d", base.ID, target.ID)
 }
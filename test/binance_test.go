@@ -13,6 +13,7 @@ import (
 	"github.com/bitontop/gored/exchange/binance"
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/test/conf"
+	"github.com/bitontop/gored/utils"
 )
 
 /********************Public API********************/
@@ -46,3 +47,37 @@ func InitBinance() exchange.Exchange {
 	config = nil
 	return ex
 }
+
+func Test_Binance_Accounts(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	exMan := exchange.CreateExchangeManager()
+	for _, label := range []string{"binance-a", "binance-b"} {
+		config := &exchange.Config{
+			ExName:    exchange.BINANCE,
+			Account:   label,
+			API_KEY:   label,
+			Source:    exchange.JSON_FILE,
+			SourceURI: "../data",
+		}
+		ex := binance.CreateBinance(config)
+		if ex == nil {
+			t.Fatalf("Binance %s Initial Failed", label)
+		}
+		exMan.AddAccount(label, ex)
+	}
+
+	a, _ := exMan.GetAccount("binance-a").(*binance.Binance)
+	b, _ := exMan.GetAccount("binance-b").(*binance.Binance)
+	if a == nil || b == nil || a == b || a.API_KEY == b.API_KEY {
+		t.Fatalf("Binance Accounts are not independent: %+v %+v", a, b)
+	}
+	if len(a.GetPairs()) == 0 || len(a.GetPairs()) != len(b.GetPairs()) {
+		t.Errorf("Binance Accounts don't share pairs: %d %d", len(a.GetPairs()), len(b.GetPairs()))
+	}
+	if accounts := exMan.GetAccounts(exchange.BINANCE); len(accounts) != 2 {
+		t.Errorf("Binance Accounts: %d", len(accounts))
+	}
+}
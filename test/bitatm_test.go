@@ -12,6 +12,7 @@ import (
 	"github.com/bitontop/gored/exchange"
 
 	"github.com/bitontop/gored/pair"
+	"github.com/shopspring/decimal"
 	//"github.com/bitontop/gored/exchange/bitatm"
 	//"github.com/bitontop/gored/test/conf"
 	"../exchange/bitatm"
@@ -44,8 +45,8 @@ func Test_BitatmOrder(t *testing.T) {
 	order := &exchange.Order{
 		Pair:     pair,
 		OrderID:  "123456",
-		Rate:     0.001,
-		Quantity: 100,
+		Rate:     decimal.NewFromFloat(0.001),
+		Quantity: decimal.NewFromInt(100),
 		Side:     "Buy",
 		Status:   exchange.New,
 	}
@@ -5,6 +5,7 @@ package test
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
+	"encoding/json"
 	"log"
 	"testing"
 
@@ -33,6 +34,45 @@ func Test_Bitfinex(t *testing.T) {
 	// Test_Withdraw(e, pair.Base, 1, "ADDRESS")
 }
 
+func Test_Bitfinex_V2Response(t *testing.T) {
+	wallets := bitfinex.AccountBalances{}
+	jsonWallets := `[["exchange","BTC",1.5,0,1.2,"Exchange 0.1 BTC",null],["margin","UST",100,0.01,null,null,null]]`
+	if err := json.Unmarshal([]byte(jsonWallets), &wallets); err != nil {
+		t.Fatalf("Bitfinex Wallets Unmarshal Err: %v", err)
+	}
+	if len(wallets) != 2 || wallets[0].Type != bitfinex.WALLET_EXCHANGE || wallets[0].Available != 1.2 {
+		t.Errorf("Bitfinex Wallets: %+v", wallets)
+	}
+	if wallets[1].Type != bitfinex.WALLET_MARGIN || wallets[1].Balance != 100 || wallets[1].Available != 0 {
+		t.Errorf("Bitfinex Wallets: %+v", wallets)
+	}
+
+	notification := bitfinex.Notification{}
+	jsonSubmit := `[1567590617.442,"on-req",null,null,[[30630788061,null,1567590617439,"tETHBTC",1567590617439,1567590617439,-0.5,-0.5,"EXCHANGE LIMIT",null,null,null,0,"ACTIVE",null,null,0.0201,0,0,0,null,null,null,0,null,null,null,null,null,null,null,null]],null,"SUCCESS","Submitting 1 orders."]`
+	if err := json.Unmarshal([]byte(jsonSubmit), &notification); err != nil {
+		t.Fatalf("Bitfinex Notification Unmarshal Err: %v", err)
+	}
+	if notification.Status != "SUCCESS" {
+		t.Errorf("Bitfinex Notification: %+v", notification)
+	}
+
+	orders := bitfinex.OpenOrders{}
+	if err := json.Unmarshal(notification.Data, &orders); err != nil {
+		t.Fatalf("Bitfinex Order Unmarshal Err: %v", err)
+	}
+	if len(orders) != 1 || orders[0].ID != 30630788061 || orders[0].Symbol != "tETHBTC" || orders[0].AmountOrig != -0.5 || orders[0].Status != "ACTIVE" || orders[0].Price != 0.0201 {
+		t.Errorf("Bitfinex Orders: %+v", orders)
+	}
+
+	errResponse := bitfinex.ErrorResponse{}
+	if err := json.Unmarshal([]byte(`["error",10100,"apikey: invalid"]`), &errResponse); err != nil {
+		t.Fatalf("Bitfinex Error Unmarshal Err: %v", err)
+	}
+	if errResponse.Event != "error" || errResponse.Code != 10100 || errResponse.Message != "apikey: invalid" {
+		t.Errorf("Bitfinex Error: %+v", errResponse)
+	}
+}
+
 func InitBitfinex() exchange.Exchange {
 	coin.Init()
 	pair.Init()
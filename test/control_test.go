@@ -0,0 +1,174 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/binance"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+)
+
+// slowExchange - InitData blocks until released, so a refresh can be caught running
+type slowExchange struct {
+	exchange.Exchange
+	name    exchange.ExchangeName
+	id      int
+	started chan bool
+	release chan error
+}
+
+func (e *slowExchange) GetName() exchange.ExchangeName { return e.name }
+func (e *slowExchange) GetID() int                     { return e.id }
+func (e *slowExchange) GetCoins() []*coin.Coin         { return nil }
+func (e *slowExchange) GetPairs() []*pair.Pair         { return nil }
+
+func (e *slowExchange) InitData() error {
+	e.started <- true
+	return <-e.release
+}
+
+/********************API Trigger********************/
+func Test_RefreshExData(t *testing.T) {
+	exMan := exchange.CreateExchangeManager()
+	slow := &slowExchange{name: "SLOWEX", id: 9001, started: make(chan bool, 2), release: make(chan error, 2)}
+	failing := &slowExchange{name: "FAILEX", id: 9002, started: make(chan bool, 2), release: make(chan error, 2)}
+	exMan.AddAccount("slow", slow)
+	exMan.AddAccount("failing", failing)
+
+	server := httptest.NewServer(exMan.NewControlHandler([]exchange.ExchangeName{"SLOWEX"}))
+	defer server.Close()
+
+	done := make(chan []*exchange.RefreshResult)
+	go func() { done <- exMan.RefreshExData([]exchange.ExchangeName{"SLOWEX"}) }()
+	<-slow.started
+
+	// SLOWEX is refreshing, the default exchanges of the server
+	results, status := postRefresh(t, server.URL+"/refresh")
+	if status != http.StatusConflict || len(results) != 1 || !results[0].Refused {
+		t.Errorf("Overlapping Refresh: %d %+v", status, results[0])
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	slow.release <- nil
+	if results := <-done; !results[0].Success || results[0].Duration < 10*time.Millisecond {
+		t.Errorf("Refresh SLOWEX: %+v", results[0])
+	}
+
+	// an exchange not added is a bad request, none of the exchanges named is refreshed
+	if response, err := http.Post(server.URL+"/refresh?exchange=failex&exchange=NOEXCHANGE", "", nil); err != nil || response.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Refresh FAILEX NOEXCHANGE: %v %v", response, err)
+	}
+	if len(failing.started) != 0 {
+		t.Errorf("FAILEX Refreshed with NOEXCHANGE")
+	}
+
+	failing.release <- errors.New("exchange offline")
+	results, status = postRefresh(t, server.URL+"/refresh?exchange=failex")
+	if status != http.StatusInternalServerError || len(results) != 1 {
+		t.Fatalf("Refresh FAILEX: %d %v", status, results)
+	}
+	if results[0].ExName != "FAILEX" || results[0].Success || results[0].Refused || results[0].Error != "exchange offline" {
+		t.Errorf("Refresh FAILEX: %+v", results[0])
+	}
+
+	slow.release <- nil
+	if results, status := postRefresh(t, server.URL+"/refresh?exchange=SLOWEX"); status != http.StatusOK || !results[0].Success {
+		t.Errorf("Refresh SLOWEX again: %d %+v", status, results[0])
+	}
+	if response, err := http.Get(server.URL + "/refresh"); err != nil || response.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("GET refresh: %v %v", response, err)
+	}
+}
+
+// go test ./test/ -race -run Test_RefreshExData_Lookups
+func Test_RefreshExData_Lookups(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	config := &exchange.Config{
+		ExName:    exchange.BINANCE,
+		Source:    exchange.JSON_FILE,
+		SourceURI: "../data",
+	}
+	ex := binance.CreateBinance(config)
+	if ex == nil {
+		t.Fatalf("Binance Initial Failed")
+	}
+	// an earlier test may have created Binance on the coins and pairs before Init
+	if err := ex.InitData(); err != nil {
+		t.Fatalf("Binance InitData: %v", err)
+	}
+	exMan := exchange.CreateExchangeManager()
+	exMan.AddAccount("binance-refresh", ex)
+
+	pairs := ex.GetPairs()
+	if len(pairs) == 0 {
+		t.Fatalf("Binance has no pairs")
+	}
+
+	// the same data is reloaded, a lookup finds every pair before, during and after each refresh
+	stop := make(chan bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for n := i; ; n++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				p := pairs[n%len(pairs)]
+				pairConstraint := ex.GetPairConstraint(p)
+				if pairConstraint == nil || ex.GetPairBySymbol(pairConstraint.ExSymbol) != p {
+					t.Errorf("Lookup %s during Refresh Failed", p.Name)
+					return
+				}
+				if ex.GetCoinBySymbol(ex.GetSymbolByCoin(p.Base)) != p.Base {
+					t.Errorf("Lookup %s during Refresh Failed", p.Base.Code)
+					return
+				}
+			}
+		}(i)
+	}
+
+	for i := 0; i < 5; i++ {
+		if results := exMan.RefreshExData([]exchange.ExchangeName{exchange.BINANCE}); !results[0].Success {
+			t.Errorf("Refresh Binance: %+v", results[0])
+		}
+	}
+	close(stop)
+	wg.Wait()
+
+	if len(ex.GetPairs()) != len(pairs) {
+		t.Errorf("Binance Pairs after Refresh: %d, want %d", len(ex.GetPairs()), len(pairs))
+	}
+}
+
+func postRefresh(t *testing.T, url string) ([]*exchange.RefreshResult, int) {
+	response, err := http.Post(url, "", nil)
+	if err != nil {
+		t.Fatalf("POST %s: %v", url, err)
+	}
+	defer response.Body.Close()
+
+	results := []*exchange.RefreshResult{}
+	if err := json.NewDecoder(response.Body).Decode(&results); err != nil {
+		t.Fatalf("POST %s Json Decode Err: %v", url, err)
+	}
+	return results, response.StatusCode
+}
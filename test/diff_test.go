@@ -0,0 +1,77 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/utils"
+	"github.com/shopspring/decimal"
+)
+
+/********************Snapshot Diff********************/
+func Test_DiffSnapshots(t *testing.T) {
+	exNames := []exchange.ExchangeName{exchange.BINANCE, exchange.HUOBI}
+	if diffs, err := utils.DiffSnapshots("../data", "../data", exNames); err != nil || len(diffs) != 0 {
+		t.Fatalf("Diff of the same snapshot: %v %v", diffs, err)
+	}
+
+	dir, err := ioutil.TempDir("", "gored-diff")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	common, err := ioutil.ReadFile("../data/common.json")
+	if err != nil {
+		t.Fatalf("Read common.json: %v", err)
+	}
+	ioutil.WriteFile(dir+"/common.json", common, 0644)
+
+	// BINANCE: the first coin delisted, the second can't withdraw, the first pair removed, the second has a new tick
+	data, err := ioutil.ReadFile("../data/BINANCE.json")
+	if err != nil {
+		t.Fatalf("Read BINANCE.json: %v", err)
+	}
+	jsonData := &utils.JsonData{}
+	if err := json.Unmarshal(data, jsonData); err != nil {
+		t.Fatalf("BINANCE.json: %v", err)
+	}
+	jsonData.CoinConstraint = jsonData.CoinConstraint[1:]
+	jsonData.CoinConstraint[0].Withdraw = !jsonData.CoinConstraint[0].Withdraw
+	jsonData.PairConstraint = jsonData.PairConstraint[1:]
+	jsonData.PairConstraint[0].PriceFilter = decimal.RequireFromString("0.25")
+	data, _ = json.Marshal(jsonData)
+	ioutil.WriteFile(dir+"/BINANCE.json", data, 0644)
+
+	// HUOBI is missing from the new snapshot, everything is removed
+	diffs, err := utils.DiffSnapshots("../data", dir, exNames)
+	if err != nil {
+		t.Fatalf("DiffSnapshots: %v", err)
+	}
+
+	count := make(map[exchange.ExchangeName]map[utils.DiffType]int)
+	for _, diff := range diffs {
+		if count[diff.ExName] == nil {
+			count[diff.ExName] = make(map[utils.DiffType]int)
+		}
+		count[diff.ExName][diff.Type]++
+	}
+	if len(count[exchange.BINANCE]) != 4 || count[exchange.BINANCE][utils.COIN_REMOVED] != 1 || count[exchange.BINANCE][utils.WITHDRAW_CHANGED] != 1 ||
+		count[exchange.BINANCE][utils.PAIR_REMOVED] != 1 || count[exchange.BINANCE][utils.PRICE_FILTER_CHANGED] != 1 {
+		t.Errorf("BINANCE Diffs: %v", count[exchange.BINANCE])
+	}
+	if len(count[exchange.HUOBI]) != 2 || count[exchange.HUOBI][utils.COIN_REMOVED] == 0 || count[exchange.HUOBI][utils.PAIR_REMOVED] == 0 {
+		t.Errorf("HUOBI Diffs: %v", count[exchange.HUOBI])
+	}
+
+	if data, err := json.Marshal(diffs[0]); err != nil || diffs[0].ExName != exchange.BINANCE {
+		t.Errorf("Diff JSON: %s %v", data, err)
+	}
+}
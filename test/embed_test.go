@@ -0,0 +1,104 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"testing"
+	"testing/fstest"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/data"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/binance"
+	"github.com/bitontop/gored/exchange/binancedex"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+)
+
+/********************Embedded Data********************/
+func Test_EmbeddedData(t *testing.T) {
+	for _, name := range []string{"common.json", "BINANCE.json"} {
+		embedded, err := fs.ReadFile(data.FS, name)
+		if err != nil {
+			t.Fatalf("Embedded %s: %v", name, err)
+		}
+		file, _ := ioutil.ReadFile("../data/" + name)
+		if !bytes.Equal(embedded, file) {
+			t.Errorf("Embedded %s is not ../data/%s", name, name)
+		}
+	}
+
+	// zero config, the embedded data is the default source
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("")
+	if len(coin.GetCoins()) == 0 || len(pair.GetPairs()) == 0 {
+		t.Fatalf("No Coins or Pairs from the Embedded Data")
+	}
+
+	config := &exchange.Config{
+		ExName: exchange.BINANCE,
+		Source: exchange.JSON_FILE,
+	}
+	e := binance.CreateBinance(config)
+	if e == nil {
+		t.Fatalf("Binance Initial from the Embedded Data Failed")
+	}
+
+	exchangeData := utils.GetExchangeDataFromFS(os.DirFS("../data"), exchange.BINANCE)
+	if exchangeData == nil || exchangeData.CoinConstraint.Count() != len(e.GetCoins()) || exchangeData.PairConstraint.Count() != len(e.GetPairs()) {
+		t.Errorf("GetExchangeDataFromFS does not match the Embedded Data")
+	}
+}
+
+func Test_DataPath(t *testing.T) {
+	// short or missing paths are an error, not a panic
+	for _, datapath := range []string{"x", "htt", "./nodata"} {
+		if utils.GetExchangeDataFromJSON(datapath, exchange.BINANCE) != nil {
+			t.Errorf("GetExchangeDataFromJSON(%q) Should Fail", datapath)
+		}
+		utils.GetCommonDataFromJSON(datapath)
+		if errs := utils.ValidateSnapshot(datapath, []exchange.ExchangeName{exchange.BINANCE}); len(errs) != 1 {
+			t.Errorf("ValidateSnapshot(%q): %v", datapath, errs)
+		}
+	}
+
+	if utils.GetExchangeDataFromFS(fstest.MapFS{}, exchange.BINANCE) != nil {
+		t.Errorf("GetExchangeDataFromFS of an empty FS Should Fail")
+	}
+	fsys := fstest.MapFS{"BINANCE.json": {Data: []byte(`{"version":1,"coinconstraint":[],"pairconstraint":[]}`)}}
+	if exchangeData := utils.GetExchangeDataFromFS(fsys, exchange.BINANCE); exchangeData == nil || exchangeData.PairConstraint.Count() != 0 {
+		t.Errorf("GetExchangeDataFromFS of an empty BINANCE.json Failed")
+	}
+}
+
+func Test_SourceFS(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	// the adapter reads its file from the SourceFS of the config, not from SourceURI
+	p := pair.GetPairByKey("BTC|ETH")
+	fsys := fstest.MapFS{"BINANCEDEX.json": {Data: []byte(fmt.Sprintf(`{"version":1,
+		"coinconstraint":[{"CoinID":%d,"ExSymbol":"BTCB"},{"CoinID":%d,"ExSymbol":"ETH"}],
+		"pairconstraint":[{"PairID":%d,"ExSymbol":"ETH_BTCB","LotSize":"0.001","PriceFilter":"0.000001"}]}`, p.Base.ID, p.Target.ID, p.ID))}}
+	e := binancedex.CreateBinanceDex(&exchange.Config{
+		ExName:    exchange.BINANCEDEX,
+		Source:    exchange.JSON_FILE,
+		SourceURI: "./nodata",
+		SourceFS:  fsys,
+	})
+	if e == nil || len(e.GetCoins()) != 2 || len(e.GetPairs()) != 1 {
+		t.Fatalf("BinanceDex Initial from SourceFS Failed: %v", e)
+	}
+	if e.GetSymbolByPair(p) != "ETH_BTCB" || e.GetPairConstraint(p).LotSize.String() != "0.001" {
+		t.Errorf("BinanceDex %s: %+v", e.GetSymbolByPair(p), e.GetPairConstraint(p))
+	}
+}
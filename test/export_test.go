@@ -0,0 +1,74 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/binance"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+)
+
+/********************Export********************/
+func Test_Export(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	config := &exchange.Config{
+		ExName:    exchange.BINANCE,
+		Source:    exchange.JSON_FILE,
+		SourceURI: "../data",
+	}
+	e := binance.CreateBinance(config)
+	if e == nil {
+		t.Fatalf("Binance Initial Failed")
+	}
+
+	dir, err := ioutil.TempDir("", "gored-export")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := [][]byte{}
+	for i := 0; i < 2; i++ {
+		if err := utils.ConvertBaseDataToJson(dir); err != nil {
+			t.Fatalf("ConvertBaseDataToJson: %v", err)
+		}
+		if err := utils.ConvertExchangeDataToJson(dir, e); err != nil {
+			t.Fatalf("ConvertExchangeDataToJson: %v", err)
+		}
+		for _, name := range []string{"common.json", "BINANCE.json"} {
+			data, err := ioutil.ReadFile(dir + "/" + name)
+			if err != nil {
+				t.Fatalf("Read %s: %v", name, err)
+			}
+			files = append(files, data)
+		}
+	}
+	// the same data is exported byte for byte
+	if !bytes.Equal(files[0], files[2]) || !bytes.Equal(files[1], files[3]) {
+		t.Errorf("Export is not deterministic")
+	}
+
+	commonData := utils.GetCommonData()
+	for i := 1; i < len(commonData.Coins); i++ {
+		if commonData.Coins[i-1].ID >= commonData.Coins[i].ID {
+			t.Fatalf("Coins not sorted by ID at %d", i)
+		}
+	}
+
+	exchangeData := utils.GetExchangeDataFromJSON(dir, exchange.BINANCE)
+	if exchangeData == nil || exchangeData.CoinConstraint.Count() != len(e.GetCoins()) || exchangeData.PairConstraint.Count() != len(e.GetPairs()) {
+		t.Errorf("Exported BINANCE.json does not load back")
+	}
+}
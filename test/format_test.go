@@ -0,0 +1,144 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/binance"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+	"github.com/shopspring/decimal"
+)
+
+/********************Data Format********************/
+func Test_DataFormat(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	// the files in ../data have no version and capitalized keys
+	config := &exchange.Config{
+		ExName:    exchange.BINANCE,
+		Source:    exchange.JSON_FILE,
+		SourceURI: "../data",
+	}
+	e := binance.CreateBinance(config)
+	if e == nil || len(e.GetCoins()) == 0 || len(e.GetPairs()) == 0 {
+		t.Fatalf("Binance Initial from the unversioned data Failed")
+	}
+
+	dir, err := ioutil.TempDir("", "gored-format")
+	if err != nil {
+		t.Fatalf("TempDir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := utils.ConvertExchangeDataToJson(dir, e); err != nil {
+		t.Fatalf("ConvertExchangeDataToJson: %v", err)
+	}
+	data, err := ioutil.ReadFile(dir + "/BINANCE.json")
+	if err != nil {
+		t.Fatalf("Read BINANCE.json: %v", err)
+	}
+	if !strings.HasPrefix(string(data), `{"version":1,"coinconstraint":[`) {
+		t.Errorf("BINANCE.json header: %.40s", data)
+	}
+	if errs := utils.ValidateSnapshot(dir, []exchange.ExchangeName{exchange.BINANCE}); len(errs) != 1 || !strings.Contains(errs[0].Error(), "common.json") {
+		t.Errorf("ValidateSnapshot without common.json: %v", errs)
+	}
+
+	// a newer format is refused instead of read wrong
+	ioutil.WriteFile(dir+"/BINANCE.json", []byte(`{"version":99,"coinconstraint":[],"pairconstraint":[]}`), 0644)
+	if utils.GetExchangeDataFromJSON(dir, exchange.BINANCE) != nil {
+		t.Errorf("Version 99 Should Fail")
+	}
+}
+
+func Test_ValidateData(t *testing.T) {
+	btc := &coin.Coin{ID: 1, Code: "BTC"}
+	eth := &coin.Coin{ID: 2, Code: "ETH"}
+	commonData := &utils.CommonData{
+		Coins: []*coin.Coin{btc, eth, {ID: 3, Code: "ETH"}, {ID: 2, Code: "LTC"}},
+		Pairs: []*pair.Pair{
+			{ID: 1, Name: "BTC|ETH", Base: btc, Target: eth},
+			{ID: 2, Name: "BTC|XRP", Base: btc, Target: &coin.Coin{ID: 9, Code: "XRP"}},
+			{ID: 3, Name: "BTC|ETH", Base: btc, Target: eth},
+		},
+		Aliases: []*exchange.Alias{{ExName: exchange.BINANCE, Symbol: "BCHSV", Code: "BSV"}},
+	}
+	err := utils.ValidateCommonData("common", commonData)
+	if dataErr, ok := err.(*utils.DataError); !ok || len(dataErr.Problems) != 5 {
+		t.Errorf("Common Data Problems: %v", err)
+	}
+
+	jsonData := &utils.JsonData{
+		CoinConstraint: []*exchange.CoinConstraint{
+			{CoinID: 1, ExSymbol: "BTC", TxFee: 0.0005},
+			{CoinID: 2, ExSymbol: "BTC", TxFee: -1},
+			{CoinID: 9, ExSymbol: "XRP"},
+		},
+		PairConstraint: []*exchange.PairConstraint{
+			{PairID: 1, ExSymbol: "ETHBTC", MakerFee: -0.00025, TakerFee: 0.001, LotSize: decimal.New(1, -3), PriceFilter: decimal.New(1, -6)},
+			{PairID: 1, ExSymbol: "ETHBTC2", TakerFee: -0.001},
+			{PairID: 7, ExSymbol: "ETHBTC"},
+		},
+	}
+	err = utils.ValidateJsonData("BINANCE", jsonData, commonData)
+	dataErr, ok := err.(*utils.DataError)
+	if !ok {
+		t.Fatalf("Exchange Data Problems: %v", err)
+	}
+	// duplicate symbol, negative tx fee, unknown coin, duplicate ID, negative taker fee, unknown pair, duplicate symbol
+	if len(dataErr.Problems) != 7 {
+		t.Errorf("Exchange Data %d Problems: %v", len(dataErr.Problems), err)
+	}
+	for _, problem := range dataErr.Problems {
+		if strings.Contains(problem, "maker") {
+			t.Errorf("Maker Rebate is not a Problem: %s", problem)
+		}
+	}
+
+	if err := utils.ValidateJsonData("BINANCE", &utils.JsonData{CoinConstraint: jsonData.CoinConstraint[:1], PairConstraint: jsonData.PairConstraint[:1]}, commonData); err != nil {
+		t.Errorf("Valid Data: %v", err)
+	}
+}
+
+func Test_DataNull(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+	btc := coin.GetCoin("BTC")
+
+	// null entries are problems to report, not to load
+	utils.GetCommonDataFromFS(fstest.MapFS{"common.json": {Data: []byte(`{"Coins":[null],"Pairs":[null,{"ID":999999,"Base":null,"Target":null}],"Aliases":[null]}`)}})
+	if pair.GetPairByID(999999) != nil {
+		t.Errorf("Pair without coins loaded")
+	}
+
+	data := fstest.MapFS{"BINANCE.json": {Data: []byte(`{"CoinConstraint":[null],"PairConstraint":[null]}`)}}
+	exchangeData := utils.GetExchangeDataFromFS(data, exchange.BINANCE)
+	if exchangeData == nil || exchangeData.CoinConstraint.Count() != 0 || exchangeData.PairConstraint.Count() != 0 {
+		t.Errorf("Null Constraints: %+v", exchangeData)
+	}
+
+	// a constraint of an unknown coin is skipped, or refuses the file with StrictData
+	data = fstest.MapFS{"BINANCE.json": {Data: []byte(fmt.Sprintf(`{"CoinConstraint":[{"CoinID":%d,"ExSymbol":"BTC"},{"CoinID":-1,"ExSymbol":"NONE"}]}`, btc.ID))}}
+	if exchangeData := utils.GetExchangeDataFromFS(data, exchange.BINANCE); exchangeData == nil || exchangeData.CoinConstraint.Count() != 1 {
+		t.Errorf("Unknown Coin Constraint: %+v", exchangeData)
+	}
+	utils.StrictData = true
+	defer func() { utils.StrictData = false }()
+	if utils.GetExchangeDataFromFS(data, exchange.BINANCE) != nil {
+		t.Errorf("Unknown Coin Constraint loaded with StrictData")
+	}
+}
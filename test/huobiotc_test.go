@@ -6,6 +6,7 @@ package test
 
 import (
 	"log"
+	"math"
 	"testing"
 
 	"github.com/bitontop/gored/coin"
@@ -33,6 +34,44 @@ func Test_HuobiOTC(t *testing.T) {
 	// Test_Withdraw(e, pair.Base, 1, "ADDRESS")
 }
 
+/********************P2P Advertisement********************/
+func Test_HuobiOTC_EffectivePrice(t *testing.T) {
+	book := &huobiotc.AdvertBook{
+		Asks: []*huobiotc.Advertisement{
+			{ID: 1, Price: 7.10, Quantity: 1000, MinTradeLimit: 5000, MaxTradeLimit: 7100},
+			{ID: 2, Price: 7.00, Quantity: 100, MinTradeLimit: 100, MaxTradeLimit: 700},
+			{ID: 3, Price: 7.20, Quantity: 1000, MinTradeLimit: 100, MaxTradeLimit: 50000},
+		},
+		Bids: []*huobiotc.Advertisement{
+			{ID: 4, Price: 6.90, Quantity: 100, MinTradeLimit: 1000, MaxTradeLimit: 690},
+			{ID: 5, Price: 6.80, Quantity: 1000, MinTradeLimit: 100, MaxTradeLimit: 6800},
+		},
+	}
+
+	// 700 at 7.00, then 1000 is below the 5000 minimum of 7.10, so it goes to 7.20
+	price, err := book.BuyPrice(1700)
+	if err != nil {
+		t.Fatalf("HuobiOTC BuyPrice Err: %v", err)
+	}
+	expected := 1700 / (700/7.00 + 1000/7.20)
+	if math.Abs(price-expected) > 1e-9 {
+		t.Errorf("HuobiOTC BuyPrice: %v, expected %v", price, expected)
+	}
+
+	// 6.90 can never trade, its minimum is above its maximum
+	price, err = book.SellPrice(1000)
+	if err != nil {
+		t.Fatalf("HuobiOTC SellPrice Err: %v", err)
+	}
+	if price != 6.80 {
+		t.Errorf("HuobiOTC SellPrice: %v, expected 6.80", price)
+	}
+
+	if _, err := book.SellPrice(10000); err == nil {
+		t.Errorf("HuobiOTC SellPrice should fail beyond the advert limits")
+	}
+}
+
 func InitHuobiOTC() exchange.Exchange {
 	coin.Init()
 	pair.Init()
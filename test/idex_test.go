@@ -1,6 +1,7 @@
 package test
 
 import (
+	"encoding/hex"
 	"log"
 	"testing"
 
@@ -34,6 +35,83 @@ func Test_Idex(t *testing.T) {
 	// Test_Withdraw(e, pair.Base, 1, "ADDRESS")
 }
 
+/********************Signature********************/
+
+// Test_IdexSignature checks the IDEX hash schema and Ethereum signing offline
+func Test_IdexSignature(t *testing.T) {
+	privateKey := "0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	address := "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+	eth := "0x0000000000000000000000000000000000000000"
+	token := "0xb705268213d593b8fd88d3fdeff93aff5cbdcfae"
+
+	// web3.eth.accounts.sign("Some data", privateKey)
+	signature, err := idex.SignMessage([]byte("Some data"), privateKey)
+	if err != nil {
+		t.Fatalf("Idex SignMessage Err: %v", err)
+	}
+	checkIdexSignature(t, "Some data", signature, 28,
+		"0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd",
+		"0x6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a029")
+
+	orderHash, err := idex.OrderHash(token, "1000000000000000000000", eth, "150000000000000000", 100000, 2650, address)
+	if err != nil {
+		t.Fatalf("Idex OrderHash Err: %v", err)
+	}
+	checkIdexHash(t, "Order", orderHash, "3c8950bcdf6e5512814283c7d37e950c43562325dbbbc6c74f348e4b943fcb84")
+	signature, err = idex.SignMessage(orderHash, privateKey)
+	if err != nil {
+		t.Fatalf("Idex SignMessage Err: %v", err)
+	}
+	checkIdexSignature(t, "Order", signature, 28,
+		"0xb30b029a5b5b29b0d50521dd4604a3139f45e7681b3bd5b84c71802952a2e91b",
+		"0x7262d8b194492fd159835f1dcb1686ba0a114e3c64cf8171a95af1f391cbf93d")
+
+	withdrawHash, err := idex.WithdrawHash(eth, "100000000000000000", address, 2651)
+	if err != nil {
+		t.Fatalf("Idex WithdrawHash Err: %v", err)
+	}
+	checkIdexHash(t, "Withdraw", withdrawHash, "151ad5714f20944c6a61b4bb44c2a7986b7385c45b70d621d16264300c6141b9")
+	signature, err = idex.SignMessage(withdrawHash, privateKey)
+	if err != nil {
+		t.Fatalf("Idex SignMessage Err: %v", err)
+	}
+	checkIdexSignature(t, "Withdraw", signature, 28,
+		"0xdac882218293ba432f6ae3fb64f911970744679aa3cc6fcc05eee6efc7c7d1a2",
+		"0x3f538bc24c2f8324f86840a852d7f54afbc847f59a8d661b5b9dd12c9ffc26dc")
+
+	cancelHash, err := idex.CancelHash("0x"+hex.EncodeToString(withdrawHash), 2652)
+	if err != nil {
+		t.Fatalf("Idex CancelHash Err: %v", err)
+	}
+	checkIdexHash(t, "Cancel", cancelHash, "249574645dd4eb447bcea4e8cfff620c8d11336f7c57ff950aa06d7689bfd696")
+	signature, err = idex.SignMessage(cancelHash, privateKey)
+	if err != nil {
+		t.Fatalf("Idex SignMessage Err: %v", err)
+	}
+	checkIdexSignature(t, "Cancel", signature, 28,
+		"0xed80b98d95996c1a016031b885a0c489368383aba2f6734e468a5ce139ff3dc1",
+		"0x38f23bb9204450f05e90a1005d115668eedc776cfc9a463e36acb21a4b4f8bba")
+
+	if _, err := idex.WithdrawHash("0x1234", "1", address, 1); err == nil {
+		t.Errorf("Idex WithdrawHash accepted an invalid token address")
+	}
+	if _, err := idex.OrderHash(token, "-1", eth, "1", 1, 1, address); err == nil {
+		t.Errorf("Idex OrderHash accepted a negative amount")
+	}
+}
+
+func checkIdexHash(t *testing.T, name string, hash []byte, expected string) {
+	if hex.EncodeToString(hash) != expected {
+		t.Errorf("Idex %s Hash: %x, expected %s", name, hash, expected)
+	}
+}
+
+func checkIdexSignature(t *testing.T, name string, signature *idex.Signature, v uint8, r, s string) {
+	if signature.V != v || signature.R != r || signature.S != s {
+		t.Errorf("Idex %s Signature: %+v, expected v=%d r=%s s=%s", name, signature, v, r, s)
+	}
+}
+
 func InitIdex() exchange.Exchange {
 	coin.Init()
 	pair.Init()
@@ -2,6 +2,8 @@ package test
 
 import (
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/bitontop/gored/coin"
@@ -10,6 +12,7 @@ import (
 	"github.com/bitontop/gored/exchange/kraken"
 	"github.com/bitontop/gored/pair"
 	"github.com/bitontop/gored/test/conf"
+	"github.com/bitontop/gored/utils"
 )
 
 // Copyright (c) 2015-2019 Bitontop Technologies Inc.
@@ -48,3 +51,91 @@ func InitKraken() exchange.Exchange {
 	config = nil
 	return ex
 }
+
+/********************Asset Names********************/
+// the altnames are built from the JSON data, nothing is sent to the exchange
+
+func initKrakenJSON(t *testing.T) *kraken.Kraken {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	e := kraken.CreateKraken(&exchange.Config{
+		ExName:     exchange.KRAKEN,
+		API_KEY:    "key",
+		API_SECRET: "c2VjcmV0",
+		Source:     exchange.JSON_FILE,
+		SourceURI:  "../data",
+	})
+	if e == nil {
+		t.Fatalf("Kraken Initial Failed")
+	}
+	return e
+}
+
+func Test_Kraken_GetAssetKey(t *testing.T) {
+	e := initKrakenJSON(t)
+
+	cases := map[string]string{
+		"XXBT": "XXBT",
+		"XBT":  "XXBT",
+		"BTC":  "XXBT",
+		"XDG":  "XXDG",
+		"DOGE": "XXDG",
+		"ETH":  "XETH",
+		"USD":  "ZUSD",
+		"ADA":  "ADA",
+	}
+	for symbol, key := range cases {
+		if got := e.GetAssetKey(symbol); got != key {
+			t.Errorf("Kraken GetAssetKey(%s) = %s, want %s", symbol, got, key)
+		}
+	}
+	if c := e.GetCoinBySymbol("XBT"); c == nil || c.Code != "BTC" {
+		t.Errorf("Kraken GetCoinBySymbol(XBT) = %v, want BTC", c)
+	}
+}
+
+func Test_Kraken_PairNames(t *testing.T) {
+	e := initKrakenJSON(t)
+
+	cases := map[string]string{
+		"XBTUSD":  "XXBTZUSD",
+		"XBT/USD": "XXBTZUSD",
+		"ETHXBT":  "XETHXXBT",
+		"ETH/XBT": "XETHXXBT",
+		"ADAUSD":  "ADAUSD",
+		"ADA/USD": "ADAUSD",
+		"XDG/XBT": "XXDGXXBT",
+	}
+	for name, key := range cases {
+		p := e.GetPairBySymbol(name)
+		if p == nil || p != e.GetPairBySymbol(key) {
+			t.Errorf("Kraken GetPairBySymbol(%s) = %v, want the pair of %s", name, p, key)
+		}
+	}
+}
+
+func Test_Kraken_BalanceSkipHold(t *testing.T) {
+	e := initKrakenJSON(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/0/private/Balance" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"error":[],"result":{"XXBT":"1.5","XXBT.S":"2","ZUSD":"10","ZUSD.HOLD":"3"}}`))
+	}))
+	defer server.Close()
+	defer func(url string) { kraken.API_URL = url }(kraken.API_URL)
+	kraken.API_URL = server.URL
+
+	e.UpdateAllBalances()
+	if balance := e.GetBalance(coin.GetCoin("BTC")); balance != 1.5 {
+		t.Errorf("Kraken BTC balance = %v, want 1.5 without the staked XXBT.S", balance)
+	}
+	if balance := e.GetBalance(coin.GetCoin("USD")); balance != 10 {
+		t.Errorf("Kraken USD balance = %v, want 10 without ZUSD.HOLD", balance)
+	}
+}
@@ -0,0 +1,76 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/binance"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+)
+
+/********************Microservice Data Server********************/
+func Test_Microservice(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	config := &exchange.Config{
+		ExName:    exchange.BINANCE,
+		Source:    exchange.JSON_FILE,
+		SourceURI: "../data",
+	}
+	e := binance.CreateBinance(config)
+	if e == nil {
+		t.Fatalf("Binance Initial Failed")
+	}
+	exchange.CreateExchangeManager().AddAccount("microservice", e)
+
+	server := httptest.NewServer(utils.NewDataHandler())
+	defer server.Close()
+
+	coins := len(coin.GetCoins())
+	utils.GetCommonDataFromMicroservice(server.URL)
+	if len(coin.GetCoins()) != coins {
+		t.Errorf("Coins %d, %d after loading from the microservice", coins, len(coin.GetCoins()))
+	}
+
+	exchangeData := utils.GetExchangeDataFromMicroservice(server.URL, exchange.BINANCE)
+	if exchangeData == nil {
+		t.Fatalf("GetExchangeDataFromMicroservice Failed")
+	}
+	if exchangeData.CoinConstraint.Count() != len(e.GetCoins()) || exchangeData.PairConstraint.Count() != len(e.GetPairs()) {
+		t.Errorf("Microservice %d Coins %d Pairs, JSON %d Coins %d Pairs", exchangeData.CoinConstraint.Count(), exchangeData.PairConstraint.Count(), len(e.GetCoins()), len(e.GetPairs()))
+	}
+	for _, p := range e.GetPairs() {
+		tmp, ok := exchangeData.PairConstraint.Get(fmt.Sprintf("%d", p.ID))
+		if !ok {
+			t.Errorf("Pair %s Not Served", p.Name)
+			continue
+		}
+		want, got := e.GetPairConstraint(p), tmp.(*exchange.PairConstraint)
+		if got.Pair != p || got.ExSymbol != want.ExSymbol || !got.LotSize.Equal(want.LotSize) || !got.PriceFilter.Equal(want.PriceFilter) {
+			t.Errorf("Pair %s: %+v, want %+v", p.Name, got, want)
+		}
+	}
+
+	// the server URI is also a JSON_FILE source
+	if exchangeData := utils.GetExchangeDataFromJSON(server.URL, exchange.BINANCE); exchangeData == nil || exchangeData.PairConstraint.Count() != len(e.GetPairs()) {
+		t.Errorf("GetExchangeDataFromJSON from the microservice Failed")
+	}
+
+	if utils.GetExchangeDataFromMicroservice(server.URL, "NOEXCHANGE") != nil {
+		t.Errorf("Unknown Exchange Should Fail")
+	}
+	if response, err := http.Post(server.URL+"/common.json", "application/json", nil); err != nil || response.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST common.json: %v %v", response, err)
+	}
+}
@@ -0,0 +1,299 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/binance"
+	"github.com/bitontop/gored/initial"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+)
+
+/********************Registry Benchmark********************/
+// go test ./test/ -run NONE -bench Registry -benchmem
+func initRegistry(b *testing.B) exchange.Exchange {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	config := &exchange.Config{
+		ExName:    exchange.BINANCE,
+		Source:    exchange.JSON_FILE,
+		SourceURI: "../data",
+	}
+	ex := binance.CreateBinance(config)
+	if ex == nil {
+		b.Fatalf("Binance Initial Failed")
+	}
+	return ex
+}
+
+func Benchmark_Registry_GetCoin(b *testing.B) {
+	initRegistry(b)
+	coins := coin.GetCoins()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if coin.GetCoin(coins[i%len(coins)].Code) == nil {
+			b.Fatalf("GetCoin %s Failed", coins[i%len(coins)].Code)
+		}
+	}
+}
+
+func Benchmark_Registry_GetPair(b *testing.B) {
+	initRegistry(b)
+	pairs := pair.GetPairs()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p := pairs[i%len(pairs)]
+		if pair.GetPair(p.Base, p.Target) != p {
+			b.Fatalf("GetPair %s Failed", p.Name)
+		}
+	}
+}
+
+func Benchmark_Registry_GetPairByKey(b *testing.B) {
+	initRegistry(b)
+	pairs := pair.GetPairs()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if pair.GetPairByKey(pairs[i%len(pairs)].Name) == nil {
+			b.Fatalf("GetPairByKey %s Failed", pairs[i%len(pairs)].Name)
+		}
+	}
+}
+
+func Benchmark_Registry_GetCoinBySymbol(b *testing.B) {
+	e := initRegistry(b)
+	symbols := []string{}
+	for _, c := range e.GetCoins() {
+		symbols = append(symbols, e.GetSymbolByCoin(c))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if e.GetCoinBySymbol(symbols[i%len(symbols)]) == nil {
+			b.Fatalf("GetCoinBySymbol %s Failed", symbols[i%len(symbols)])
+		}
+	}
+}
+
+func Benchmark_Registry_GetPairBySymbol(b *testing.B) {
+	e := initRegistry(b)
+	symbols := []string{}
+	for _, p := range e.GetPairs() {
+		symbols = append(symbols, e.GetSymbolByPair(p))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if e.GetPairBySymbol(symbols[i%len(symbols)]) == nil {
+			b.Fatalf("GetPairBySymbol %s Failed", symbols[i%len(symbols)])
+		}
+	}
+}
+
+/********************Registry Race********************/
+// go test -race ./test/ -run Registry
+func Test_Registry_ConcurrentInit(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	initMan := initial.CreateInitManager()
+	exMan := exchange.CreateExchangeManager()
+	exNames := []exchange.ExchangeName{exchange.BINANCE, exchange.HUOBI, exchange.OKEX, exchange.KRAKEN, exchange.POLONIEX, exchange.BITTREX}
+
+	var wg sync.WaitGroup
+	for _, exName := range exNames {
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func(exName exchange.ExchangeName, label string) {
+				defer wg.Done()
+				config := &exchange.Config{
+					ExName:    exName,
+					Account:   label,
+					Source:    exchange.JSON_FILE,
+					SourceURI: "../data",
+				}
+				initMan.Init(config)
+			}(exName, fmt.Sprintf("race-%s-%d", exName, i))
+		}
+	}
+	wg.Wait()
+
+	for _, exName := range exNames {
+		first := exMan.GetAccount(fmt.Sprintf("race-%s-0", exName))
+		if first == nil || len(first.GetPairs()) == 0 {
+			t.Fatalf("%s Account race-%s-0 Not Registered", exName, exName)
+		}
+		for i := 1; i < 3; i++ {
+			e := exMan.GetAccount(fmt.Sprintf("race-%s-%d", exName, i))
+			if e == nil || e == first || len(e.GetPairs()) != len(first.GetPairs()) {
+				t.Errorf("%s Account race-%s-%d: %v", exName, exName, i, e)
+			}
+		}
+	}
+}
+
+// new coins and pairs from many goroutines at once, eg: exchanges listing the same new coin while initializing
+func Test_Registry_ConcurrentGetOrCreate(t *testing.T) {
+	coin.Init()
+	pair.Init()
+
+	codes := []string{"RACEBASE", "RACEA", "RACEB", "RACEC", "RACED"}
+	coins := make([][]*coin.Coin, 16)
+	pairs := make([][]*pair.Pair, len(coins))
+
+	var wg sync.WaitGroup
+	for w := range coins {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for _, code := range codes {
+				coins[w] = append(coins[w], coin.GetOrAddCoin(&coin.Coin{Code: code}))
+			}
+			for _, target := range coins[w][1:] {
+				pairs[w] = append(pairs[w], pair.GetPair(coins[w][0], target))
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	// one instance per key, the one registered
+	for i, code := range codes {
+		c := coin.GetCoin(code)
+		for w := range coins {
+			if c == nil || coins[w][i] != c {
+				t.Fatalf("Coin %s: worker %d got %+v, registered %+v", code, w, coins[w][i], c)
+			}
+		}
+	}
+	for i := range pairs[0] {
+		p := pair.GetPairByKey(pair.GetKey(coins[0][0], coins[0][i+1]))
+		for w := range pairs {
+			if p == nil || pairs[w][i] != p {
+				t.Fatalf("Pair %d: worker %d got %+v, registered %+v", i, w, pairs[w][i], p)
+			}
+		}
+	}
+
+	// the IDs are the ones of any other run, the hash of the key with nothing taken
+	none := func(int) bool { return false }
+	if id := coin.GetCoin("RACEBASE").ID; id != 643450201 {
+		t.Errorf("Coin RACEBASE ID %d, want 643450201 in every run", id)
+	}
+	for _, c := range coins[0] {
+		if id, _ := coin.GenerateStableID(c.Code, none); c.ID != id {
+			t.Errorf("Coin %s ID %d, want %d", c.Code, c.ID, id)
+		}
+	}
+	for _, p := range pairs[0] {
+		if id, _ := coin.GenerateStableID(fmt.Sprintf("%d|%d", p.Base.ID, p.Target.ID), none); p.ID != id {
+			t.Errorf("Pair %s ID %d, want %d", p.Name, p.ID, id)
+		}
+	}
+}
+
+func Test_Registry_StableID(t *testing.T) {
+	free := func(id int) bool { return false }
+	id, err := coin.GenerateStableID("BTC", free)
+	if again, _ := coin.GenerateStableID("BTC", free); err != nil || id != again || id < coin.GENERATED_ID_BASE {
+		t.Fatalf("GenerateStableID BTC: %d %v", id, err)
+	}
+
+	// a taken ID is hashed again with a salt, the same fallback in every run
+	taken := func(taken int) bool { return taken == id }
+	next, err := coin.GenerateStableID("BTC", taken)
+	if again, _ := coin.GenerateStableID("BTC", taken); err != nil || next == id || next != again || next < coin.GENERATED_ID_BASE {
+		t.Errorf("GenerateStableID taken BTC: %d %d %v", next, again, err)
+	}
+	if all, err := coin.GenerateStableID("BTC", func(int) bool { return true }); err == nil {
+		t.Errorf("GenerateStableID all taken BTC: %d", all)
+	}
+}
+
+func Test_Registry_GetOrAddCoin(t *testing.T) {
+	coin.Init()
+
+	added := coin.GetOrAddCoin(&coin.Coin{Code: "getoradd"})
+	if added == nil || added.Code != "GETORADD" || added.ID < coin.GENERATED_ID_BASE || coin.GetCoin("GETORADD") != added {
+		t.Fatalf("GetOrAddCoin GETORADD: %+v", added)
+	}
+	// the coin registered is returned, not the one passed in
+	other := &coin.Coin{Code: "GETORADD", Name: "Other"}
+	if c := coin.GetOrAddCoin(other); c != added || other.ID != 0 {
+		t.Errorf("GetOrAddCoin registered GETORADD: %+v %+v", c, other)
+	}
+	if coin.GetOrAddCoin(&coin.Coin{}) != nil || coin.AddCoin(&coin.Coin{Code: "NOID"}) == nil {
+		t.Errorf("Coin without code or ID added")
+	}
+}
+
+func Test_Registry_ContractConflict(t *testing.T) {
+	coin.Init()
+
+	added := exchange.GetOrAddCoin(exchange.BINANCE, "CONFLICTA", &coin.Coin{Chain: "ETH", Contract: "0xabc"})
+	if added == nil || added.Code != "CONFLICTA" || added.Contract != "0xabc" {
+		t.Fatalf("GetOrAddCoin CONFLICTA: %+v", added)
+	}
+	// the same contract in another case, on another chain, or unknown is the same asset
+	for _, c := range []*coin.Coin{{Chain: "ETH", Contract: "0xABC"}, {Chain: "TRX", Contract: "Tabc"}, {}} {
+		if same := exchange.GetOrAddCoin(exchange.KUCOIN, "CONFLICTA", c); same != added {
+			t.Errorf("GetOrAddCoin %+v: %+v", c, same)
+		}
+	}
+
+	if other := exchange.GetOrAddCoin(exchange.GATEIO, "conflicta", &coin.Coin{Chain: "ETH", Contract: "0xdef"}); other != nil {
+		t.Fatalf("GetOrAddCoin merged another contract: %+v", other)
+	}
+	found := false
+	for _, conflict := range exchange.GetContractConflicts() {
+		if conflict.ExName == exchange.GATEIO && conflict.Registered == added && conflict.Coin.Contract == "0xdef" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Contract Conflict Not Found")
+	}
+}
+
+/********************Registry Alias********************/
+func Test_Registry_Alias(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	if c := exchange.GetCoin(exchange.KUCOIN, "HOT"); c == nil || c.Code != "HOT2" {
+		t.Errorf("KuCoin HOT: %+v", c)
+	}
+	if c := exchange.GetCoin(exchange.BINANCE, "bchabc"); c == nil || c.Code != "BCH" {
+		t.Errorf("Binance BCHABC: %+v", c)
+	}
+	if code := exchange.GetCoinCode(exchange.BINANCE, "HOT"); code != "HOT" {
+		t.Errorf("Binance HOT: %s", code)
+	}
+
+	found := false
+	for _, conflict := range utils.GetCoinConflicts("../data", []exchange.ExchangeName{exchange.KUCOIN, exchange.BINANCE}) {
+		if conflict.Code == "CQBTC" && conflict.ExName == "" {
+			found = true
+		}
+		if conflict.ExName == exchange.KUCOIN && conflict.Code == "HOT2" {
+			t.Errorf("KuCoin HOT2 has an alias: %s", conflict.Issue)
+		}
+	}
+	if !found {
+		t.Errorf("CQBTC Conflict Not Found")
+	}
+}
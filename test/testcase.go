@@ -11,6 +11,7 @@ import (
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/shopspring/decimal"
 )
 
 /********************Public API********************/
@@ -120,8 +121,8 @@ func Test_OrderStatus(e exchange.Exchange, p *pair.Pair, orderID string) {
 	order := &exchange.Order{
 		Pair:     p,
 		OrderID:  orderID,
-		Rate:     0.001,
-		Quantity: 100,
+		Rate:     decimal.NewFromFloat(0.001),
+		Quantity: decimal.NewFromInt(100),
 		Side:     "Buy",
 		Status:   exchange.New,
 	}
@@ -138,8 +139,8 @@ func Test_CancelOrder(e exchange.Exchange, p *pair.Pair, orderID string) {
 	order := &exchange.Order{
 		Pair:     p,
 		OrderID:  orderID,
-		Rate:     0.001,
-		Quantity: 10,
+		Rate:     decimal.NewFromFloat(0.001),
+		Quantity: decimal.NewFromInt(10),
 		Side:     "Buy",
 		Status:   exchange.New,
 	}
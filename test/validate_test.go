@@ -0,0 +1,139 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"testing"
+
+	"github.com/bitontop/gored/exchange"
+	"github.com/shopspring/decimal"
+)
+
+/********************Validate Withdraw********************/
+func Test_ValidateWithdraw(t *testing.T) {
+	coinConstraint := &exchange.CoinConstraint{
+		ExSymbol:     "USDT",
+		MinWithdraw:  10,
+		WithdrawStep: 0.01,
+	}
+
+	for quantity, valid := range map[float64]bool{
+		10:      true,
+		12.34:   true,
+		9.99:    false,
+		12.345:  false,
+		0:       false,
+		1000000: true,
+	} {
+		if err := exchange.ValidateWithdraw(coinConstraint, quantity); (err == nil) != valid {
+			t.Errorf("ValidateWithdraw %v: %v", quantity, err)
+		}
+	}
+
+	// a large quantity on a fine step, a float tolerance on quantity/step refuses it
+	fine := &exchange.CoinConstraint{
+		ExSymbol:     "ETH",
+		WithdrawStep: 0.000001,
+	}
+	for quantity, valid := range map[float64]bool{
+		12345.6789:      true,
+		98765432.123456: true,
+		12345.6789001:   false,
+	} {
+		if err := exchange.ValidateWithdraw(fine, quantity); (err == nil) != valid {
+			t.Errorf("ValidateWithdraw %v: %v", quantity, err)
+		}
+	}
+
+	if err := exchange.ValidateWithdraw(nil, 1); err != nil {
+		t.Errorf("ValidateWithdraw without constraint: %v", err)
+	}
+}
+
+/********************Validate Order********************/
+func Test_ValidateOrder(t *testing.T) {
+	pairConstraint := &exchange.PairConstraint{
+		ExSymbol:          "ETHBTC",
+		LotSize:           decimal.NewFromFloat(0.001),
+		PriceFilter:       decimal.NewFromFloat(0.000001),
+		MinNotional:       decimal.RequireFromString("0.001"),
+		MinQuantity:       decimal.RequireFromString("0.001"),
+		MaxQuantity:       decimal.RequireFromString("100"),
+		MarketMinQuantity: decimal.RequireFromString("0.01"),
+	}
+
+	// a buy rate is rounded down and a sell rate up, never past the caller's limit
+	quantity, rate, err := exchange.ValidateOrder(pairConstraint, "Buy", 0.30000009, 0.0312345678)
+	if err != nil || quantity.String() != "0.3" || rate.String() != "0.031234" {
+		t.Errorf("ValidateOrder Buy Rounding: %v %v %v", quantity, rate, err)
+	}
+	quantity, rate, err = exchange.ValidateOrder(pairConstraint, "Sell", 0.30000009, 0.0312345678)
+	if err != nil || quantity.String() != "0.3" || rate.String() != "0.031235" {
+		t.Errorf("ValidateOrder Sell Rounding: %v %v %v", quantity, rate, err)
+	}
+	if _, _, err := exchange.ValidateOrder(pairConstraint, "buy", 1, 0.03); err == nil {
+		t.Errorf("ValidateOrder Unknown Side Should Fail")
+	}
+
+	for _, order := range [][2]float64{
+		{0.0009, 0.03}, // rounded to 0
+		{0.01, 0.03},   // value 0.0003 under min notional
+		{101, 0.03},    // over max quantity
+		{1, 0.0000001}, // rate rounded to 0
+		{0.005, 0},     // under market min quantity
+	} {
+		if _, _, err := exchange.ValidateOrder(pairConstraint, "Buy", order[0], order[1]); err == nil {
+			t.Errorf("ValidateOrder %v Should Fail", order)
+		}
+	}
+
+	if _, _, err := exchange.ValidateOrder(pairConstraint, "Sell", 0.05, 0); err != nil {
+		t.Errorf("ValidateOrder Market: %v", err)
+	}
+
+	pairConstraint.PriceFilter = decimal.RequireFromString("0.25")
+	if _, rate, err := exchange.ValidateOrder(pairConstraint, "Buy", 1, 12.3); err != nil || rate.String() != "12.25" {
+		t.Errorf("ValidateOrder Buy 0.25 Tick: %v %v", rate, err)
+	}
+	if _, rate, err := exchange.ValidateOrder(pairConstraint, "Sell", 1, 12.3); err != nil || rate.String() != "12.5" {
+		t.Errorf("ValidateOrder Sell 0.25 Tick: %v %v", rate, err)
+	}
+
+	// the value is exact, 0.7 * 0.1 is 0.06999999999999999 in float64
+	notional := &exchange.PairConstraint{
+		ExSymbol:    "ETHBTC",
+		LotSize:     decimal.RequireFromString("0.1"),
+		PriceFilter: decimal.RequireFromString("0.1"),
+		MinNotional: decimal.RequireFromString("0.07"),
+	}
+	if _, _, err := exchange.ValidateOrder(notional, "Buy", 0.7, 0.1); err != nil {
+		t.Errorf("ValidateOrder Exact Min Notional: %v", err)
+	}
+}
+
+/********************Format Step********************/
+func Test_FormatStep(t *testing.T) {
+	for _, c := range []struct {
+		value float64
+		step  string
+		param string
+	}{
+		{12.3, "0.25", "12.25"},
+		{12.76, "0.5", "13"},
+		{0.30000000000000004, "0.1", "0.3"},
+		{0.0312345678, "0.000001", "0.031235"},
+		{1234.5, "10", "1230"},
+		{1234.5, "0", "1234.5"},
+	} {
+		if param := exchange.FormatStep(c.value, decimal.RequireFromString(c.step)); param != c.param {
+			t.Errorf("FormatStep %v on %s: %s, want %s", c.value, c.step, param, c.param)
+		}
+	}
+
+	step := decimal.RequireFromString("0.25")
+	if floor := exchange.RoundStep(decimal.RequireFromString("12.49"), step, decimal.Decimal.Floor); floor.String() != "12.25" {
+		t.Errorf("RoundStep Floor 12.49 on 0.25: %s", floor)
+	}
+}
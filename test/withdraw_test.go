@@ -0,0 +1,187 @@
+package test
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/exchange/bitfinex"
+	"github.com/bitontop/gored/exchange/gateio"
+	"github.com/bitontop/gored/pair"
+	"github.com/bitontop/gored/utils"
+)
+
+/********************Withdraw Signing********************/
+// the withdraw requests are checked against a local server, nothing is sent to the exchanges
+
+func hexHmac(hash func() hash.Hash, message, secret string) string {
+	mac := hmac.New(hash, []byte(secret))
+	mac.Write([]byte(message))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func Test_Bitfinex_Withdraw(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	payload := map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/conf/pub:map:tx:method":
+			w.Write([]byte(`[[["ETHEREUM",["ETH"]],["BITCOIN",["BTC"]]]]`))
+		case "/v1/withdraw":
+			payloadEnc := r.Header.Get("X-BFX-PAYLOAD")
+			if r.Header.Get("X-BFX-APIKEY") != "key" || r.Header.Get("X-BFX-SIGNATURE") != hexHmac(sha512.New384, payloadEnc, "secret") {
+				t.Errorf("Bitfinex Withdraw bad signature: %v", r.Header)
+			}
+			data, _ := base64.StdEncoding.DecodeString(payloadEnc)
+			if err := json.Unmarshal(data, &payload); err != nil {
+				t.Errorf("Bitfinex Withdraw bad payload: %s", data)
+			}
+			w.Write([]byte(`[{"status":"success","message":"","withdrawal_id":1}]`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer func(url string) { bitfinex.API_URL = url }(bitfinex.API_URL)
+	bitfinex.API_URL = server.URL
+
+	e := bitfinex.CreateBitfinex(&exchange.Config{
+		ExName:     exchange.BITFINEX,
+		API_KEY:    "key",
+		API_SECRET: "secret",
+		Source:     exchange.JSON_FILE,
+		SourceURI:  "../data",
+	})
+	if e == nil {
+		t.Fatalf("Bitfinex Initial Failed")
+	}
+
+	if !e.Withdraw(coin.GetCoin("BTC"), 0.5, "ADDRESS", "") {
+		t.Fatalf("Bitfinex Withdraw Failed")
+	}
+	expected := map[string]interface{}{
+		"request":        "/v1/withdraw",
+		"withdraw_type":  "bitcoin",
+		"walletselected": "exchange",
+		"amount":         "0.5",
+		"address":        "ADDRESS",
+	}
+	for key, value := range expected {
+		if payload[key] != value {
+			t.Errorf("Bitfinex Withdraw %s = %v, want %v", key, payload[key], value)
+		}
+	}
+	if _, ok := payload["payment_id"]; ok {
+		t.Errorf("Bitfinex Withdraw sent payment_id without a tag")
+	}
+}
+
+func Test_Gateio_Withdraw(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	params := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v4/wallet/withdrawals" {
+			http.NotFound(w, r)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		hashedBody := sha512.Sum512(body)
+		strSign := strings.Join([]string{"POST", "/api/v4/wallet/withdrawals", "", hex.EncodeToString(hashedBody[:]), r.Header.Get("Timestamp")}, "\n")
+		if r.Header.Get("KEY") != "key" || r.Header.Get("SIGN") != hexHmac(sha512.New, strSign, "secret") {
+			t.Errorf("Gateio Withdraw bad signature: %v", r.Header)
+		}
+		if err := json.Unmarshal(body, &params); err != nil {
+			t.Errorf("Gateio Withdraw bad body: %s", body)
+		}
+		w.Write([]byte(`{"id":"w1","currency":"ETH","amount":"0.5","status":"REQUEST"}`))
+	}))
+	defer server.Close()
+	defer func(url string) { gateio.API_URL = url }(gateio.API_URL)
+	gateio.API_URL = server.URL
+
+	e := gateio.CreateGateio(&exchange.Config{
+		ExName:     exchange.GATEIO,
+		API_KEY:    "key",
+		API_SECRET: "secret",
+		Source:     exchange.JSON_FILE,
+		SourceURI:  "../data",
+	})
+	if e == nil {
+		t.Fatalf("Gateio Initial Failed")
+	}
+
+	if !e.Withdraw(coin.GetCoin("ETH"), 0.5, "ADDRESS", "MEMO") {
+		t.Fatalf("Gateio Withdraw Failed")
+	}
+	expected := map[string]string{
+		"currency": "ETH",
+		"amount":   "0.5",
+		"address":  "ADDRESS",
+		"memo":     "MEMO",
+	}
+	for key, value := range expected {
+		if params[key] != value {
+			t.Errorf("Gateio Withdraw %s = %v, want %v", key, params[key], value)
+		}
+	}
+}
+
+// a key without the wallet permission still loads the public coins
+func Test_Gateio_WithdrawStatusDenied(t *testing.T) {
+	coin.Init()
+	pair.Init()
+	utils.GetCommonDataFromJSON("../data")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v4/spot/currencies":
+			w.Write([]byte(`[{"currency":"ETH","delisted":false,"withdraw_disabled":false,"deposit_disabled":false}]`))
+		case "/api/v4/wallet/withdraw_status":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"label":"FORBIDDEN","message":"Permission denied"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	defer func(url string) { gateio.API_URL = url }(gateio.API_URL)
+	gateio.API_URL = server.URL
+
+	e := gateio.CreateGateio(&exchange.Config{
+		ExName:     exchange.GATEIO,
+		API_KEY:    "key",
+		API_SECRET: "secret",
+		Source:     exchange.JSON_FILE,
+		SourceURI:  "../data",
+	})
+	if e == nil {
+		t.Fatalf("Gateio Initial Failed")
+	}
+
+	if err := e.GetCoinsData(); err != nil {
+		t.Fatalf("Gateio GetCoinsData failed on the withdraw status: %v", err)
+	}
+	if e.GetCoinConstraint(coin.GetCoin("ETH")) == nil {
+		t.Errorf("Gateio lost the public coin ETH")
+	}
+}
@@ -0,0 +1,172 @@
+package utils
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+)
+
+const (
+	CONFLICT_FEE_RATIO = 100 // withdraw fees of the same coin further apart than this are likely different assets
+)
+
+// CoinConflict - a coin that might merge different assets, ExName is empty for the common data
+type CoinConflict struct {
+	Code   string
+	ExName exchange.ExchangeName
+	Issue  string
+}
+
+type coinFee struct {
+	exName exchange.ExchangeName
+	fee    float64
+}
+
+// GetCoinConflicts - checks the coins in the data snapshot, common data must be loaded first,
+// eg: go run . conflict
+func GetCoinConflicts(datapath string, exNames []exchange.ExchangeName) []*CoinConflict {
+	conflicts := []*CoinConflict{}
+
+	// the same code on different coins, GetCoin only finds one of them
+	codeIDs := make(map[string][]int)
+	for _, c := range coin.GetCoins() {
+		codeIDs[c.Code] = append(codeIDs[c.Code], c.ID)
+	}
+	for code, ids := range codeIDs {
+		if len(ids) > 1 {
+			conflicts = append(conflicts, &CoinConflict{
+				Code:  code,
+				Issue: fmt.Sprintf("code shared by coins %v", ids),
+			})
+		}
+	}
+
+	for _, alias := range exchange.GetAliases() {
+		if coin.GetCoin(alias.Code) == nil {
+			conflicts = append(conflicts, &CoinConflict{
+				Code:   alias.Code,
+				ExName: alias.ExName,
+				Issue:  fmt.Sprintf("alias of %s to an unknown coin", alias.Symbol),
+			})
+		}
+	}
+
+	fees := make(map[int][]coinFee)
+	contracts := make(map[int]map[string]exchange.ExchangeName) // coin ID -> contract -> exchange
+	for _, exName := range exNames {
+		exchangeData := GetExchangeDataFromJSON(datapath, exName)
+		if exchangeData == nil {
+			continue
+		}
+
+		constraints := []*exchange.CoinConstraint{}
+		feeCount := make(map[float64]int)
+		for _, tmp := range exchangeData.CoinConstraint.Items() {
+			coinConstraint := tmp.(*exchange.CoinConstraint)
+			constraints = append(constraints, coinConstraint)
+			feeCount[coinConstraint.TxFee]++
+		}
+
+		for _, coinConstraint := range constraints {
+			if conflict := checkSymbol(exName, coinConstraint); conflict != nil {
+				conflicts = append(conflicts, conflict)
+			}
+
+			if contract := strings.ToLower(coinConstraint.Contract); contract != "" {
+				if contracts[coinConstraint.CoinID] == nil {
+					contracts[coinConstraint.CoinID] = make(map[string]exchange.ExchangeName)
+				}
+				contracts[coinConstraint.CoinID][contract] = exName
+			}
+
+			// a fee shared by a fifth of the coins is the adapter's default, not the exchange's
+			if coinConstraint.TxFee > 0 && (feeCount[coinConstraint.TxFee] < 5 || feeCount[coinConstraint.TxFee]*5 < len(constraints)) {
+				fees[coinConstraint.CoinID] = append(fees[coinConstraint.CoinID], coinFee{exName, coinConstraint.TxFee})
+			}
+		}
+	}
+
+	for id, coinFees := range fees {
+		min, max := coinFees[0], coinFees[0]
+		for _, coinFee := range coinFees {
+			if coinFee.fee < min.fee {
+				min = coinFee
+			}
+			if coinFee.fee > max.fee {
+				max = coinFee
+			}
+		}
+		if max.fee > min.fee*CONFLICT_FEE_RATIO {
+			conflicts = append(conflicts, &CoinConflict{
+				Code:   coin.GetCoinByID(id).Code,
+				ExName: max.exName,
+				Issue:  fmt.Sprintf("withdraw fee %v, but %v on %s", max.fee, min.fee, min.exName),
+			})
+		}
+	}
+
+	// a token has one contract, a coin with several merges different tokens
+	for id, coinContracts := range contracts {
+		c := coin.GetCoinByID(id)
+		if c.Contract != "" {
+			coinContracts[strings.ToLower(c.Contract)] = ""
+		}
+		if len(coinContracts) > 1 {
+			conflicts = append(conflicts, &CoinConflict{
+				Code:  c.Code,
+				Issue: fmt.Sprintf("contracts %v", coinContracts),
+			})
+		}
+	}
+
+	// the coins refused while loading data from the exchange APIs
+	for _, conflict := range exchange.GetContractConflicts() {
+		conflicts = append(conflicts, &CoinConflict{
+			Code:   conflict.Registered.Code,
+			ExName: conflict.ExName,
+			Issue:  fmt.Sprintf("symbol %s is contract %s, but the coin is %s, refused", conflict.Symbol, conflict.Coin.Contract, conflict.Registered.Contract),
+		})
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		if conflicts[i].Code != conflicts[j].Code {
+			return conflicts[i].Code < conflicts[j].Code
+		}
+		if conflicts[i].ExName != conflicts[j].ExName {
+			return conflicts[i].ExName < conflicts[j].ExName
+		}
+		return conflicts[i].Issue < conflicts[j].Issue
+	})
+	return conflicts
+}
+
+// checkSymbol - an exchange symbol that is the code of another coin needs an alias saying which asset it is
+func checkSymbol(exName exchange.ExchangeName, coinConstraint *exchange.CoinConstraint) *CoinConflict {
+	symbol := strings.TrimSpace(strings.ToUpper(coinConstraint.ExSymbol))
+	alias := exchange.GetAlias(exName, symbol)
+
+	switch {
+	case alias != nil && alias.Code != coinConstraint.Coin.Code:
+		return &CoinConflict{
+			Code:   coinConstraint.Coin.Code,
+			ExName: exName,
+			Issue:  fmt.Sprintf("symbol %s, but the alias is %s", symbol, alias.Code),
+		}
+	case alias == nil && symbol != coinConstraint.Coin.Code:
+		if other := coin.GetCoin(symbol); other != nil && other.ID != coinConstraint.CoinID {
+			return &CoinConflict{
+				Code:   coinConstraint.Coin.Code,
+				ExName: exName,
+				Issue:  fmt.Sprintf("symbol %s is coin %s(%d) on other exchanges, but there is no alias", symbol, other.Code, other.ID),
+			}
+		}
+	}
+	return nil
+}
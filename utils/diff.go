@@ -0,0 +1,235 @@
+package utils
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/bitontop/gored/exchange"
+)
+
+type DiffType string
+
+const (
+	COIN_ADDED           DiffType = "COIN_ADDED"
+	COIN_REMOVED         DiffType = "COIN_REMOVED"
+	PAIR_ADDED           DiffType = "PAIR_ADDED"
+	PAIR_REMOVED         DiffType = "PAIR_REMOVED"
+	TX_FEE_CHANGED       DiffType = "TX_FEE_CHANGED"
+	MAKER_FEE_CHANGED    DiffType = "MAKER_FEE_CHANGED"
+	TAKER_FEE_CHANGED    DiffType = "TAKER_FEE_CHANGED"
+	LOT_SIZE_CHANGED     DiffType = "LOT_SIZE_CHANGED"
+	PRICE_FILTER_CHANGED DiffType = "PRICE_FILTER_CHANGED"
+	WITHDRAW_CHANGED     DiffType = "WITHDRAW_CHANGED"
+	DEPOSIT_CHANGED      DiffType = "DEPOSIT_CHANGED"
+)
+
+// DataDiff - one change of an exchange between two snapshots, Old is empty for an added coin or pair, New for a removed one
+type DataDiff struct {
+	ExName exchange.ExchangeName `json:"exchange"`
+	Type   DiffType              `json:"type"`
+	Coin   string                `json:"coin,omitempty"`
+	Pair   string                `json:"pair,omitempty"`
+	Old    interface{}           `json:"old,omitempty"`
+	New    interface{}           `json:"new,omitempty"`
+}
+
+// exchangeSnapshot - the constraints of an exchange keyed by coin code and pair name,
+// snapshots with different IDs for the same coin still compare
+type exchangeSnapshot struct {
+	coins map[string]*exchange.CoinConstraint
+	pairs map[string]*exchange.PairConstraint
+}
+
+// DiffSnapshots - the changes of the exchanges from the snapshot in oldPath to the one in newPath,
+// an exchange missing from a snapshot has no coins or pairs in it
+func DiffSnapshots(oldPath, newPath string, exNames []exchange.ExchangeName) ([]*DataDiff, error) {
+	oldCommon, err := readCommonData(oldPath)
+	if err != nil {
+		return nil, err
+	}
+	newCommon, err := readCommonData(newPath)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := []*DataDiff{}
+	for _, exName := range exNames {
+		old, err := readSnapshot(oldPath, exName, oldCommon)
+		if err != nil {
+			return nil, err
+		}
+		new, err := readSnapshot(newPath, exName, newCommon)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diffExchange(exName, old, new)...)
+	}
+	sortDiffs(diffs)
+	return diffs, nil
+}
+
+// DiffLive - the changes of the exchange from the snapshot in datapath to the data it has loaded, eg: by InitData from its API
+func DiffLive(datapath string, e exchange.Exchange) ([]*DataDiff, error) {
+	common, err := readCommonData(datapath)
+	if err != nil {
+		return nil, err
+	}
+
+	live := &exchangeSnapshot{
+		coins: make(map[string]*exchange.CoinConstraint),
+		pairs: make(map[string]*exchange.PairConstraint),
+	}
+	for _, c := range e.GetCoins() {
+		if coinConstraint := e.GetCoinConstraint(c); coinConstraint != nil {
+			live.coins[c.Code] = coinConstraint
+		}
+	}
+	for _, p := range e.GetPairs() {
+		if pairConstraint := e.GetPairConstraint(p); pairConstraint != nil {
+			live.pairs[p.Name] = pairConstraint
+		}
+	}
+
+	old, err := readSnapshot(datapath, e.GetName(), common)
+	if err != nil {
+		return nil, err
+	}
+	diffs := diffExchange(e.GetName(), old, live)
+	sortDiffs(diffs)
+	return diffs, nil
+}
+
+func diffExchange(exName exchange.ExchangeName, old, new *exchangeSnapshot) []*DataDiff {
+	diffs := []*DataDiff{}
+	for code, oldCoin := range old.coins {
+		newCoin, ok := new.coins[code]
+		if !ok {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: COIN_REMOVED, Coin: code, Old: oldCoin.ExSymbol})
+			continue
+		}
+		if oldCoin.TxFee != newCoin.TxFee {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: TX_FEE_CHANGED, Coin: code, Old: oldCoin.TxFee, New: newCoin.TxFee})
+		}
+		if oldCoin.Withdraw != newCoin.Withdraw {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: WITHDRAW_CHANGED, Coin: code, Old: oldCoin.Withdraw, New: newCoin.Withdraw})
+		}
+		if oldCoin.Deposit != newCoin.Deposit {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: DEPOSIT_CHANGED, Coin: code, Old: oldCoin.Deposit, New: newCoin.Deposit})
+		}
+	}
+	for code, newCoin := range new.coins {
+		if _, ok := old.coins[code]; !ok {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: COIN_ADDED, Coin: code, New: newCoin.ExSymbol})
+		}
+	}
+
+	for name, oldPair := range old.pairs {
+		newPair, ok := new.pairs[name]
+		if !ok {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: PAIR_REMOVED, Pair: name, Old: oldPair.ExSymbol})
+			continue
+		}
+		if oldPair.MakerFee != newPair.MakerFee {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: MAKER_FEE_CHANGED, Pair: name, Old: oldPair.MakerFee, New: newPair.MakerFee})
+		}
+		if oldPair.TakerFee != newPair.TakerFee {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: TAKER_FEE_CHANGED, Pair: name, Old: oldPair.TakerFee, New: newPair.TakerFee})
+		}
+		if !oldPair.LotSize.Equal(newPair.LotSize) {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: LOT_SIZE_CHANGED, Pair: name, Old: oldPair.LotSize, New: newPair.LotSize})
+		}
+		if !oldPair.PriceFilter.Equal(newPair.PriceFilter) {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: PRICE_FILTER_CHANGED, Pair: name, Old: oldPair.PriceFilter, New: newPair.PriceFilter})
+		}
+	}
+	for name, newPair := range new.pairs {
+		if _, ok := old.pairs[name]; !ok {
+			diffs = append(diffs, &DataDiff{ExName: exName, Type: PAIR_ADDED, Pair: name, New: newPair.ExSymbol})
+		}
+	}
+	return diffs
+}
+
+func sortDiffs(diffs []*DataDiff) {
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].ExName != diffs[j].ExName {
+			return diffs[i].ExName < diffs[j].ExName
+		}
+		if diffs[i].Type != diffs[j].Type {
+			return diffs[i].Type < diffs[j].Type
+		}
+		if diffs[i].Coin != diffs[j].Coin {
+			return diffs[i].Coin < diffs[j].Coin
+		}
+		return diffs[i].Pair < diffs[j].Pair
+	})
+}
+
+// PrintDiffs - the diffs as JSON lines for the alerting, eg: go run . diff ./old ./data
+func PrintDiffs(diffs []*DataDiff) error {
+	encoder := json.NewEncoder(os.Stdout)
+	for _, diff := range diffs {
+		if err := encoder.Encode(diff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readCommonData - the common data of a snapshot without loading it, the IDs of two snapshots may differ
+func readCommonData(datapath string) (*CommonData, error) {
+	fileName := fmt.Sprintf("%s/common.json", datapath)
+	data, err := readData(datapath, fileName)
+	if err != nil {
+		return nil, err
+	}
+	return decodeCommonData(fileName, data)
+}
+
+// readSnapshot - the constraints of the exchange in the snapshot keyed by the codes in its common data,
+// empty if the snapshot doesn't have the exchange
+func readSnapshot(datapath string, exName exchange.ExchangeName, commonData *CommonData) (*exchangeSnapshot, error) {
+	snapshot := &exchangeSnapshot{
+		coins: make(map[string]*exchange.CoinConstraint),
+		pairs: make(map[string]*exchange.PairConstraint),
+	}
+
+	fileName := fmt.Sprintf("%s/%s.json", datapath, exName)
+	data, err := readData(datapath, fileName)
+	if os.IsNotExist(err) {
+		return snapshot, nil
+	} else if err != nil {
+		return nil, err
+	}
+	jsonData, err := decodeJsonData(fileName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make(map[int]string)
+	for _, c := range commonData.Coins {
+		codes[c.ID] = c.Code
+	}
+	names := make(map[int]string)
+	for _, p := range commonData.Pairs {
+		names[p.ID] = p.Name
+	}
+
+	for _, cc := range jsonData.CoinConstraint {
+		if code, ok := codes[cc.CoinID]; ok {
+			snapshot.coins[code] = cc
+		}
+	}
+	for _, pc := range jsonData.PairConstraint {
+		if name, ok := names[pc.PairID]; ok {
+			snapshot.pairs[name] = pc
+		}
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,61 @@
+package utils
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/bitontop/gored/exchange"
+)
+
+// ConvertBaseDataToJson - writes the coins, pairs and aliases loaded to <datapath>/common.json sorted by ID,
+// load the previous common.json first so IDs stay the same and coins of exchanges offline now are kept
+func ConvertBaseDataToJson(datapath string) error {
+	commonData := GetCommonData()
+	if len(commonData.Coins) == 0 {
+		return fmt.Errorf("no coins loaded, %s/common.json is kept", datapath)
+	}
+	return writeJson(fmt.Sprintf("%s/common.json", datapath), commonData)
+}
+
+// ConvertExchangeDataToJson - writes the constraints of the exchange to <datapath>/<EXCHANGE>.json sorted by ID,
+// an exchange without coins or pairs is offline, its file is kept
+func ConvertExchangeDataToJson(datapath string, e exchange.Exchange) error {
+	jsonData := GetJsonData(e)
+	if len(jsonData.CoinConstraint) == 0 || len(jsonData.PairConstraint) == 0 {
+		return fmt.Errorf("%s has %d coins %d pairs, %s/%s.json is kept", e.GetName(), len(jsonData.CoinConstraint), len(jsonData.PairConstraint), datapath, e.GetName())
+	}
+	return writeJson(fmt.Sprintf("%s/%s.json", datapath, e.GetName()), jsonData)
+}
+
+// writeJson - replaces the file in one step, a failed export never leaves half a file
+func writeJson(fileName string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("%s Json Marshal Err: %v", fileName, err)
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(fileName), filepath.Base(fileName)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fileName)
+}
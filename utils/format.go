@@ -0,0 +1,235 @@
+package utils
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bitontop/gored/exchange"
+)
+
+// DataError - all the problems found in a data file, not only the first one
+type DataError struct {
+	Source   string
+	Problems []string
+}
+
+func (e *DataError) Error() string {
+	return fmt.Sprintf("%s has %d problems:\n\t%s", e.Source, len(e.Problems), strings.Join(e.Problems, "\n\t"))
+}
+
+func (e *DataError) add(format string, a ...interface{}) {
+	e.Problems = append(e.Problems, fmt.Sprintf(format, a...))
+}
+
+func (e *DataError) result() error {
+	if len(e.Problems) == 0 {
+		return nil
+	}
+	return e
+}
+
+/*************** Decode ***************/
+// decodeCommonData - keys are matched ignoring case, so files written before DATA_VERSION, eg: {"Coins":...}, still read
+func decodeCommonData(source string, data []byte) (*CommonData, error) {
+	commonData := &CommonData{}
+	if err := json.Unmarshal(data, commonData); err != nil {
+		return nil, fmt.Errorf("%s Json Unmarshal Err: %v", source, err)
+	}
+	if commonData.Version > DATA_VERSION {
+		return nil, fmt.Errorf("%s is version %d, the latest supported is %d", source, commonData.Version, DATA_VERSION)
+	}
+	return commonData, nil
+}
+
+func decodeJsonData(source string, data []byte) (*JsonData, error) {
+	jsonData := &JsonData{}
+	if err := json.Unmarshal(data, jsonData); err != nil {
+		return nil, fmt.Errorf("%s Json Unmarshal Err: %v", source, err)
+	}
+	if jsonData.Version > DATA_VERSION {
+		return nil, fmt.Errorf("%s is version %d, the latest supported is %d", source, jsonData.Version, DATA_VERSION)
+	}
+	return jsonData, nil
+}
+
+/*************** Validate ***************/
+// ValidateCommonData - duplicate IDs and coins, pairs of coins not in the data and aliases of unknown codes, nil if none
+func ValidateCommonData(source string, commonData *CommonData) error {
+	dataErr := &DataError{Source: source}
+
+	coins := make(map[int]bool)
+	symbols := make(map[string]int)
+	codes := make(map[string]bool)
+	for i, c := range commonData.Coins {
+		if c == nil || c.Code == "" {
+			dataErr.add("coin #%d has no code", i)
+			continue
+		}
+		if coins[c.ID] {
+			dataErr.add("coin %d %s: duplicate ID", c.ID, c.Code)
+		}
+		coins[c.ID] = true
+		codes[strings.ToUpper(c.Code)] = true
+
+		// coins share a code only when the chain or contract tells them apart
+		symbol := fmt.Sprintf("%s|%s|%s", strings.ToUpper(c.Code), c.Chain, c.Contract)
+		if id, ok := symbols[symbol]; ok {
+			dataErr.add("coin %d %s: duplicate of coin %d", c.ID, c.Code, id)
+		} else {
+			symbols[symbol] = c.ID
+		}
+	}
+
+	pairs := make(map[int]bool)
+	bases := make(map[string]int)
+	for i, p := range commonData.Pairs {
+		if p == nil {
+			dataErr.add("pair #%d is null", i)
+			continue
+		}
+		if pairs[p.ID] {
+			dataErr.add("pair %d %s: duplicate ID", p.ID, p.Name)
+		}
+		pairs[p.ID] = true
+		if p.Base == nil || !coins[p.Base.ID] {
+			dataErr.add("pair %d %s: base coin not in the coins", p.ID, p.Name)
+			continue
+		}
+		if p.Target == nil || !coins[p.Target.ID] {
+			dataErr.add("pair %d %s: target coin not in the coins", p.ID, p.Name)
+			continue
+		}
+		key := fmt.Sprintf("%d|%d", p.Base.ID, p.Target.ID)
+		if id, ok := bases[key]; ok {
+			dataErr.add("pair %d %s: duplicate of pair %d", p.ID, p.Name, id)
+		} else {
+			bases[key] = p.ID
+		}
+	}
+
+	aliases := make(map[string]bool)
+	for i, alias := range commonData.Aliases {
+		if alias == nil {
+			dataErr.add("alias #%d is null", i)
+			continue
+		}
+		key := fmt.Sprintf("%s|%s", alias.ExName, alias.Symbol)
+		if aliases[key] {
+			dataErr.add("alias %s %s: duplicate symbol", alias.ExName, alias.Symbol)
+		}
+		aliases[key] = true
+		if !codes[strings.ToUpper(alias.Code)] {
+			dataErr.add("alias %s %s: code %s not in the coins", alias.ExName, alias.Symbol, alias.Code)
+		}
+	}
+	return dataErr.result()
+}
+
+// ValidateJsonData - constraints of coins or pairs not in the common data, duplicate IDs and symbols and negative fees, nil if none.
+// A negative maker fee is a rebate and allowed
+func ValidateJsonData(source string, jsonData *JsonData, commonData *CommonData) error {
+	dataErr := &DataError{Source: source}
+
+	coins := make(map[int]bool)
+	for _, c := range commonData.Coins {
+		if c != nil {
+			coins[c.ID] = true
+		}
+	}
+	pairs := make(map[int]bool)
+	for _, p := range commonData.Pairs {
+		if p != nil {
+			pairs[p.ID] = true
+		}
+	}
+
+	coinIDs := make(map[int]bool)
+	coinSymbols := make(map[string]int)
+	for i, cc := range jsonData.CoinConstraint {
+		if cc == nil {
+			dataErr.add("coin constraint #%d is null", i)
+			continue
+		}
+		if !coins[cc.CoinID] {
+			dataErr.add("coin %d %s: not in the coins", cc.CoinID, cc.ExSymbol)
+		}
+		if coinIDs[cc.CoinID] {
+			dataErr.add("coin %d %s: duplicate ID", cc.CoinID, cc.ExSymbol)
+		}
+		coinIDs[cc.CoinID] = true
+		if id, ok := coinSymbols[cc.ExSymbol]; ok && cc.ExSymbol != "" {
+			dataErr.add("coin %d %s: duplicate symbol of coin %d", cc.CoinID, cc.ExSymbol, id)
+		} else {
+			coinSymbols[cc.ExSymbol] = cc.CoinID
+		}
+		if cc.TxFee < 0 {
+			dataErr.add("coin %d %s: negative tx fee %v", cc.CoinID, cc.ExSymbol, cc.TxFee)
+		}
+	}
+
+	pairIDs := make(map[int]bool)
+	pairSymbols := make(map[string]int)
+	for i, pc := range jsonData.PairConstraint {
+		if pc == nil {
+			dataErr.add("pair constraint #%d is null", i)
+			continue
+		}
+		if !pairs[pc.PairID] {
+			dataErr.add("pair %d %s: not in the pairs", pc.PairID, pc.ExSymbol)
+		}
+		if pairIDs[pc.PairID] {
+			dataErr.add("pair %d %s: duplicate ID", pc.PairID, pc.ExSymbol)
+		}
+		pairIDs[pc.PairID] = true
+		if id, ok := pairSymbols[pc.ExSymbol]; ok && pc.ExSymbol != "" {
+			dataErr.add("pair %d %s: duplicate symbol of pair %d", pc.PairID, pc.ExSymbol, id)
+		} else {
+			pairSymbols[pc.ExSymbol] = pc.PairID
+		}
+		if pc.TakerFee < 0 {
+			dataErr.add("pair %d %s: negative taker fee %v", pc.PairID, pc.ExSymbol, pc.TakerFee)
+		}
+		if pc.LotSize.IsNegative() || pc.PriceFilter.IsNegative() {
+			dataErr.add("pair %d %s: negative lot size %v or price filter %v", pc.PairID, pc.ExSymbol, pc.LotSize, pc.PriceFilter)
+		}
+	}
+	return dataErr.result()
+}
+
+// ValidateSnapshot - the problems of common.json and the files of the exchanges in datapath, an exchange without a file is skipped
+func ValidateSnapshot(datapath string, exNames []exchange.ExchangeName) []error {
+	commonData, err := readCommonData(datapath)
+	if err != nil {
+		return []error{err}
+	}
+
+	errs := []error{}
+	if err := ValidateCommonData(fmt.Sprintf("%s/common.json", datapath), commonData); err != nil {
+		errs = append(errs, err)
+	}
+	for _, exName := range exNames {
+		fileName := fmt.Sprintf("%s/%s.json", datapath, exName)
+		data, err := readData(datapath, fileName)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		jsonData, err := decodeJsonData(fileName, data)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := ValidateJsonData(fileName, jsonData, commonData); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,161 @@
+package utils
+
+// Copyright (c) 2015-2019 Bitontop Technologies Inc.
+// Distributed under the MIT software license, see the accompanying
+// file COPYING or http://www.opensource.org/licenses/mit-license.php.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bitontop/gored/coin"
+	"github.com/bitontop/gored/exchange"
+	"github.com/bitontop/gored/pair"
+)
+
+const (
+	MICROSERVICE_TIMEOUT = 30 * time.Second
+)
+
+// GetCommonData - the coins, pairs and aliases loaded, sorted by ID, in the format of data/common.json
+func GetCommonData() *CommonData {
+	commonData := &CommonData{
+		Version: DATA_VERSION,
+		Coins:   coin.GetCoins(),
+		Pairs:   pair.GetPairs(),
+		Aliases: exchange.GetAliases(),
+	}
+	sort.Slice(commonData.Coins, func(i, j int) bool { return commonData.Coins[i].ID < commonData.Coins[j].ID })
+	sort.Slice(commonData.Pairs, func(i, j int) bool { return commonData.Pairs[i].ID < commonData.Pairs[j].ID })
+	return commonData
+}
+
+// GetJsonData - the constraints of the exchange sorted by ID, in the format of data/<EXCHANGE>.json,
+// Coin and Pair are left out, they are in the common data
+func GetJsonData(e exchange.Exchange) *JsonData {
+	jsonData := &JsonData{
+		Version:        DATA_VERSION,
+		CoinConstraint: []*exchange.CoinConstraint{},
+		PairConstraint: []*exchange.PairConstraint{},
+	}
+	for _, c := range e.GetCoins() {
+		if coinConstraint := e.GetCoinConstraint(c); coinConstraint != nil {
+			cc := *coinConstraint
+			cc.Coin = nil
+			jsonData.CoinConstraint = append(jsonData.CoinConstraint, &cc)
+		}
+	}
+	for _, p := range e.GetPairs() {
+		if pairConstraint := e.GetPairConstraint(p); pairConstraint != nil {
+			pc := *pairConstraint
+			pc.Pair = nil
+			jsonData.PairConstraint = append(jsonData.PairConstraint, &pc)
+		}
+	}
+	sort.Slice(jsonData.CoinConstraint, func(i, j int) bool {
+		return jsonData.CoinConstraint[i].CoinID < jsonData.CoinConstraint[j].CoinID
+	})
+	sort.Slice(jsonData.PairConstraint, func(i, j int) bool {
+		return jsonData.PairConstraint[i].PairID < jsonData.PairConstraint[j].PairID
+	})
+	return jsonData
+}
+
+/*************** Server ***************/
+// NewDataHandler - serves the data of the exchanges in the manager in the JSON_FILE format,
+// GET /common.json and GET /<EXCHANGE>.json, eg: /BINANCE.json, so the server URI also works as a JSON_FILE source.
+// The data is read on every request, keep it fresh with ExchangeManager.UpdateExData
+func NewDataHandler() http.Handler {
+	exMan := exchange.CreateExchangeManager()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/common.json", func(w http.ResponseWriter, r *http.Request) {
+		serveJson(w, r, GetCommonData())
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/")
+		if !strings.HasSuffix(name, ".json") {
+			http.NotFound(w, r)
+			return
+		}
+		e := exMan.Get(exchange.ExchangeName(strings.TrimSuffix(name, ".json")))
+		if e == nil {
+			http.NotFound(w, r)
+			return
+		}
+		serveJson(w, r, GetJsonData(e))
+	})
+	return mux
+}
+
+func serveJson(w http.ResponseWriter, r *http.Request, v interface{}) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("%s Json Marshal Err: %v", r.URL.Path, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+/*************** Client ***************/
+// GetExchangeDataFromMicroservice - the constraints of the exchange from the data server at uri, eg: http://localhost:8080
+func GetExchangeDataFromMicroservice(uri string, exName exchange.ExchangeName) *ExchangeData {
+	url := fmt.Sprintf("%s/%s.json", strings.TrimSuffix(uri, "/"), exName)
+	data, err := getMicroservice(url)
+	if err != nil {
+		log.Printf("%s Microservice Err: %v", exName, err)
+		return nil
+	}
+	jsonData, err := decodeJsonData(url, data)
+	if err != nil {
+		log.Printf("%s Microservice Err: %v", exName, err)
+		return nil
+	}
+	return loadExchangeData(url, jsonData)
+}
+
+func GetCommonDataFromMicroservice(uri string) {
+	url := fmt.Sprintf("%s/common.json", strings.TrimSuffix(uri, "/"))
+	data, err := getMicroservice(url)
+	if err != nil {
+		log.Printf("Microservice Err: %v", err)
+		return
+	}
+	commonData, err := decodeCommonData(url, data)
+	if err != nil {
+		log.Printf("Microservice Err: %v", err)
+		return
+	}
+	loadCommonData(url, commonData)
+}
+
+func getMicroservice(url string) ([]byte, error) {
+	httpClient := &http.Client{Timeout: MICROSERVICE_TIMEOUT}
+	response, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s %s: %s", url, response.Status, data)
+	}
+	return data, nil
+}
@@ -8,20 +8,24 @@ import (
 	"github.com/bitontop/gored/coin"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
-	cmap "github.com/orcaman/concurrent-map"
 )
 
+// DATA_VERSION - the version of the JSON data format written, files without a version are the format before it and read the same way
+const DATA_VERSION = 1
+
 type CommonData struct {
-	Coins []*coin.Coin `json: "coins"`
-	Pairs []*pair.Pair `json: "pairs"`
-}
+	Version int          `json:"version"`
+	Coins   []*coin.Coin `json:"coins"`
+	Pairs   []*pair.Pair `json:"pairs"`
 
-type ExchangeData struct {
-	CoinConstraint cmap.ConcurrentMap
-	PairConstraint cmap.ConcurrentMap
+	Aliases []*exchange.Alias `json:"aliases"` // the per exchange symbols of coins, see exchange.GetCoinCode
 }
 
+// ExchangeData - the constraints loaded from a data source with the symbol indexes built by GetExchangeData
+type ExchangeData = exchange.ConstraintMaps
+
 type JsonData struct {
-	CoinConstraint []*exchange.CoinConstraint `json: "coinconstraint"`
-	PairConstraint []*exchange.PairConstraint `json: "pairconstraint"`
+	Version        int                        `json:"version"`
+	CoinConstraint []*exchange.CoinConstraint `json:"coinconstraint"`
+	PairConstraint []*exchange.PairConstraint `json:"pairconstraint"`
 }
@@ -5,41 +5,101 @@ package utils
 // file COPYING or http://www.opensource.org/licenses/mit-license.php.
 
 import (
-	"encoding/json"
 	"fmt"
+	"io/fs"
 	"io/ioutil"
 	"log"
+	"strings"
 
 	"github.com/bitontop/gored/coin"
+	embedded "github.com/bitontop/gored/data"
 	"github.com/bitontop/gored/exchange"
 	"github.com/bitontop/gored/pair"
 	cmap "github.com/orcaman/concurrent-map"
 )
 
+// GetExchangeData - the constraints of the exchange from a MICROSERVICE_API, JSON_FILE or PSQL source with the symbol indexes built,
+// what the adapters load in InitData. A JSON_FILE source reads fsys instead of uri if it is set
+func GetExchangeData(source exchange.DataSource, uri string, fsys fs.FS, exName exchange.ExchangeName) (*ExchangeData, error) {
+	var exchangeData *ExchangeData
+	switch source {
+	case exchange.MICROSERVICE_API:
+		exchangeData = GetExchangeDataFromMicroservice(uri, exName)
+	case exchange.JSON_FILE:
+		if fsys != nil {
+			exchangeData = GetExchangeDataFromFS(fsys, exName)
+		} else {
+			exchangeData = GetExchangeDataFromJSON(uri, exName)
+		}
+	case exchange.PSQL:
+		exchangeData = GetExchangeDataFromPSQL(uri, exName)
+	default:
+		return nil, fmt.Errorf("%s Initial Data: %s is not a data source of constraints", exName, source)
+	}
+	if exchangeData == nil {
+		return nil, fmt.Errorf("%s Initial Data Error.", exName)
+	}
+	exchangeData.CoinSymbol = exchange.IndexCoinSymbols(exchangeData.CoinConstraint)
+	exchangeData.PairSymbol = exchange.IndexPairSymbols(exchangeData.PairConstraint)
+	return exchangeData, nil
+}
+
+// GetExchangeDataFromJSON - the constraints of the exchange from <datapath>/<EXCHANGE>.json,
+// datapath is a directory, a URL or empty for the embedded data
 func GetExchangeDataFromJSON(datapath string, exName exchange.ExchangeName) *ExchangeData {
 	fileName := fmt.Sprintf("%s/%s.json", datapath, exName)
-	exchangeData := &ExchangeData{
-		CoinConstraint: cmap.New(),
-		PairConstraint: cmap.New(),
+	data, err := readData(datapath, fileName)
+	if err != nil {
+		log.Printf("Read %s Failed: %v", fileName, err)
+		return nil
+	}
+	return parseExchangeData(fileName, data)
+}
+
+// GetExchangeDataFromFS - the constraints of the exchange from <EXCHANGE>.json in fsys, eg: os.DirFS("./data")
+func GetExchangeDataFromFS(fsys fs.FS, exName exchange.ExchangeName) *ExchangeData {
+	fileName := fmt.Sprintf("%s.json", exName)
+	data, err := fs.ReadFile(fsys, fileName)
+	if err != nil {
+		log.Printf("Read %s Failed: %v", fileName, err)
+		return nil
 	}
-	var err error
-	data := []byte{}
-	if datapath[0:4] == "http" {
-		data = []byte(exchange.HttpGetRequest(fileName, nil))
-	} else {
-		if data, err = ioutil.ReadFile(fileName); err != nil {
-			log.Printf("Read %s Failed: %v", fileName, err)
+	return parseExchangeData(fileName, data)
+}
+
+func parseExchangeData(fileName string, data []byte) *ExchangeData {
+	jsonData, err := decodeJsonData(fileName, data)
+	if err != nil {
+		log.Printf("%v", err)
+		return nil
+	}
+
+	return loadExchangeData(fileName, jsonData)
+}
+
+// StrictData - data with any problem found by validation is refused instead of loading the valid part,
+// eg: for a snapshot checked with go run . validate
+var StrictData bool
+
+// loadExchangeData - the constraints of coins and pairs that are loaded, keyed by ID,
+// the problems of the data are logged and null constraints or constraints without a coin or pair skipped
+func loadExchangeData(source string, jsonData *JsonData) *ExchangeData {
+	if err := ValidateJsonData(source, jsonData, GetCommonData()); err != nil {
+		log.Printf("%v", err)
+		if StrictData {
 			return nil
 		}
 	}
 
-	jsonData := &JsonData{}
-	if err := json.Unmarshal(data, &jsonData); err != nil {
-		log.Printf("%s Json Unmarshal Err: %v %s", fileName, err, data)
-		return nil
+	exchangeData := &ExchangeData{
+		CoinConstraint: cmap.New(),
+		PairConstraint: cmap.New(),
 	}
 
 	for _, cc := range jsonData.CoinConstraint {
+		if cc == nil {
+			continue
+		}
 		key := fmt.Sprintf("%d", cc.CoinID)
 		cc.Coin = coin.GetCoinByID(cc.CoinID)
 		if cc.Coin != nil {
@@ -48,6 +108,9 @@ func GetExchangeDataFromJSON(datapath string, exName exchange.ExchangeName) *Exc
 	}
 
 	for _, pc := range jsonData.PairConstraint {
+		if pc == nil {
+			continue
+		}
 		key := fmt.Sprintf("%d", pc.PairID)
 		pc.Pair = pair.GetPairByID(pc.PairID)
 		if pc.Pair != nil {
@@ -58,30 +121,74 @@ func GetExchangeDataFromJSON(datapath string, exName exchange.ExchangeName) *Exc
 	return exchangeData
 }
 
+// GetCommonDataFromJSON - loads the coins, pairs and aliases of <datapath>/common.json,
+// datapath is a directory, a URL or empty for the embedded data
 func GetCommonDataFromJSON(datapath string) {
 	fileName := fmt.Sprintf("%s/common.json", datapath)
-	var err error
-	data := []byte{}
-	if datapath[0:4] == "http" {
-		data = []byte(exchange.HttpGetRequest(fileName, nil))
-	} else {
-		if data, err = ioutil.ReadFile(fileName); err != nil {
-			log.Printf("Read %s Failed: %v", fileName, err)
-			return
-		}
+	data, err := readData(datapath, fileName)
+	if err != nil {
+		log.Printf("Read %s Failed: %v", fileName, err)
+		return
+	}
+	parseCommonData(fileName, data)
+}
+
+// GetCommonDataFromFS - loads the coins, pairs and aliases of common.json in fsys, eg: os.DirFS("./data")
+func GetCommonDataFromFS(fsys fs.FS) {
+	data, err := fs.ReadFile(fsys, "common.json")
+	if err != nil {
+		log.Printf("Read common.json Failed: %v", err)
+		return
 	}
+	parseCommonData("common.json", data)
+}
 
-	commonData := &CommonData{}
-	if err := json.Unmarshal(data, &commonData); err != nil {
-		log.Printf("%s Json Unmarshal Err: %v %s", datapath, err, data)
+func parseCommonData(fileName string, data []byte) {
+	commonData, err := decodeCommonData(fileName, data)
+	if err != nil {
+		log.Printf("%v", err)
 		return
 	}
 
+	loadCommonData(fileName, commonData)
+}
+
+// loadCommonData - the problems of the data are logged, null entries and pairs of coins not loaded skipped
+func loadCommonData(source string, commonData *CommonData) {
+	if err := ValidateCommonData(source, commonData); err != nil {
+		log.Printf("%v", err)
+		if StrictData {
+			return
+		}
+	}
+
 	for _, c := range commonData.Coins {
-		coin.AddCoin(c)
+		if c != nil {
+			coin.AddCoin(c)
+		}
 	}
 
 	for _, p := range commonData.Pairs {
-		pair.SetPair(p.ID, p.Base, p.Target)
+		if p == nil || p.Base == nil || p.Target == nil {
+			continue
+		}
+		pair.SetPair(p.ID, coin.GetCoinByID(p.Base.ID), coin.GetCoinByID(p.Target.ID))
+	}
+
+	for _, alias := range commonData.Aliases {
+		if alias != nil {
+			exchange.SetAlias(alias)
+		}
+	}
+}
+
+// readData - a file of the data snapshot, over HTTP if datapath is a URL, from the embedded data if it is empty
+func readData(datapath, fileName string) ([]byte, error) {
+	switch {
+	case datapath == "":
+		return fs.ReadFile(embedded.FS, strings.TrimPrefix(fileName, "/"))
+	case strings.HasPrefix(datapath, "http://") || strings.HasPrefix(datapath, "https://"):
+		return getMicroservice(fileName)
 	}
+	return ioutil.ReadFile(fileName)
 }